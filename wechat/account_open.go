@@ -0,0 +1,130 @@
+package wechat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// errCodeOpenAccountBound is the errcode returned when the account is
+// already bound to an open platform account.
+const errCodeOpenAccountBound = 89000
+
+// OpenAccountBoundError is returned by CreateOpenAccount and
+// BindOpenAccount when the account is already bound to an open platform
+// account. OpenAppID is that open platform account, if it could be fetched.
+type OpenAccountBoundError struct {
+	*ErrorResponse
+	OpenAppID string
+}
+
+func (e *OpenAccountBoundError) Error() string {
+	if e.OpenAppID == "" {
+		return fmt.Sprintf("wechat: already bound to an open platform account: %v", e.ErrorResponse)
+	}
+	return fmt.Sprintf("wechat: already bound to open platform account %s: %v", e.OpenAppID, e.ErrorResponse)
+}
+
+// Unwrap returns the underlying *ErrorResponse.
+func (e *OpenAccountBoundError) Unwrap() error { return e.ErrorResponse }
+
+// OpenAccount represents an open platform account.
+type OpenAccount struct {
+	OpenAppID string `json:"open_appid"`
+}
+
+// CreateOpenAccount create an open platform account and bind the account of
+// appID to it.
+// Wechat API docs:
+// https://developers.weixin.qq.com/doc/oplatform/Third-party_Platforms/api/account/create.html
+func (s *AccountService) CreateOpenAccount(ctx context.Context, token, appID string) (*OpenAccount, *Response, error) {
+	u := fmt.Sprintf("cgi-bin/open/create?access_token=%v", token)
+	payload := struct {
+		AppID string `json:"appid"`
+	}{AppID: appID}
+	req, err := s.client.NewRequest(http.MethodPost, u, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+	account := new(OpenAccount)
+	resp, err := s.client.Do(ctx, req, account)
+	if err != nil {
+		return nil, resp, s.openAccountError(ctx, token, appID, err)
+	}
+	return account, resp, nil
+}
+
+// BindOpenAccount bind the account of appID to the open platform account
+// openAppID.
+// Wechat API docs:
+// https://developers.weixin.qq.com/doc/oplatform/Third-party_Platforms/api/account/bind.html
+func (s *AccountService) BindOpenAccount(ctx context.Context, token, appID, openAppID string) (*Response, error) {
+	u := fmt.Sprintf("cgi-bin/open/bind?access_token=%v", token)
+	payload := struct {
+		AppID     string `json:"appid"`
+		OpenAppID string `json:"open_appid"`
+	}{AppID: appID, OpenAppID: openAppID}
+	req, err := s.client.NewRequest(http.MethodPost, u, payload)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.client.Do(ctx, req, nil)
+	if err != nil {
+		return resp, s.openAccountError(ctx, token, appID, err)
+	}
+	return resp, nil
+}
+
+// UnbindOpenAccount unbind the account of appID from the open platform
+// account openAppID.
+// Wechat API docs:
+// https://developers.weixin.qq.com/doc/oplatform/Third-party_Platforms/api/account/unbind.html
+func (s *AccountService) UnbindOpenAccount(ctx context.Context, token, appID, openAppID string) (*Response, error) {
+	u := fmt.Sprintf("cgi-bin/open/unbind?access_token=%v", token)
+	payload := struct {
+		AppID     string `json:"appid"`
+		OpenAppID string `json:"open_appid"`
+	}{AppID: appID, OpenAppID: openAppID}
+	req, err := s.client.NewRequest(http.MethodPost, u, payload)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+// GetOpenAccount fetch the open platform account the account of appID is
+// bound to.
+// Wechat API docs:
+// https://developers.weixin.qq.com/doc/oplatform/Third-party_Platforms/api/account/get.html
+func (s *AccountService) GetOpenAccount(ctx context.Context, token, appID string) (*OpenAccount, *Response, error) {
+	u := fmt.Sprintf("cgi-bin/open/get?access_token=%v", token)
+	payload := struct {
+		AppID string `json:"appid"`
+	}{AppID: appID}
+	req, err := s.client.NewRequest(http.MethodPost, u, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+	account := new(OpenAccount)
+	resp, err := s.client.Do(ctx, req, account)
+	if err != nil {
+		return nil, resp, err
+	}
+	return account, resp, nil
+}
+
+// openAccountError turns the "already bound" errcode into an
+// *OpenAccountBoundError, fetching the bound open platform account.
+func (s *AccountService) openAccountError(ctx context.Context, token, appID string, err error) error {
+	if errorCode(err) != errCodeOpenAccountBound {
+		return err
+	}
+	var e *ErrorResponse
+	errors.As(err, &e)
+	bound := &OpenAccountBoundError{ErrorResponse: e}
+	if account, _, err := s.GetOpenAccount(ctx, token, appID); err == nil {
+		bound.OpenAppID = account.OpenAppID
+	}
+	return bound
+}
@@ -0,0 +1,121 @@
+package wechat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestAccountService_CreateOpenAccount(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/cgi-bin/open/create", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"appid":"wxappid"}`+"\n")
+		fmt.Fprint(w, `{"errcode": 0, "errmsg": "ok", "open_appid": "wxopenappid"}`)
+	})
+	got, _, err := client.Account.CreateOpenAccount(context.Background(), "token", "wxappid")
+	if err != nil {
+		t.Errorf("Account.CreateOpenAccount returned error: %v", err)
+	}
+	want := &OpenAccount{OpenAppID: "wxopenappid"}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Account.CreateOpenAccount returned %+v, want %+v", got, want)
+	}
+}
+
+func TestAccountService_CreateOpenAccount_bound(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/cgi-bin/open/create", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"errcode": 89000, "errmsg": "account has bound open"}`)
+	})
+	mux.HandleFunc("/cgi-bin/open/get", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"errcode": 0, "errmsg": "ok", "open_appid": "wxotheropenappid"}`)
+	})
+	_, _, err := client.Account.CreateOpenAccount(context.Background(), "token", "wxappid")
+	var bound *OpenAccountBoundError
+	if !errors.As(err, &bound) {
+		t.Fatalf("Account.CreateOpenAccount err = %v, want *OpenAccountBoundError", err)
+	}
+	if bound.OpenAppID != "wxotheropenappid" {
+		t.Errorf("OpenAccountBoundError.OpenAppID = %q, want %q", bound.OpenAppID, "wxotheropenappid")
+	}
+	if errorCode(err) != 89000 {
+		t.Errorf("OpenAccountBoundError does not unwrap to the *ErrorResponse: %v", err)
+	}
+}
+
+func TestAccountService_BindOpenAccount(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/cgi-bin/open/bind", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"appid":"wxappid","open_appid":"wxopenappid"}`+"\n")
+		fmt.Fprint(w, `{"errcode": 0, "errmsg": "ok"}`)
+	})
+	_, err := client.Account.BindOpenAccount(context.Background(), "token", "wxappid", "wxopenappid")
+	if err != nil {
+		t.Errorf("Account.BindOpenAccount returned error: %v", err)
+	}
+}
+
+func TestAccountService_BindOpenAccount_bound(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/cgi-bin/open/bind", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"errcode": 89000, "errmsg": "account has bound open"}`)
+	})
+	mux.HandleFunc("/cgi-bin/open/get", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"errcode": 61003, "errmsg": "component is not authorized by this account"}`)
+	})
+	_, err := client.Account.BindOpenAccount(context.Background(), "token", "wxappid", "wxopenappid")
+	var bound *OpenAccountBoundError
+	if !errors.As(err, &bound) {
+		t.Fatalf("Account.BindOpenAccount err = %v, want *OpenAccountBoundError", err)
+	}
+	if bound.OpenAppID != "" {
+		t.Errorf("OpenAccountBoundError.OpenAppID = %q, want empty", bound.OpenAppID)
+	}
+}
+
+func TestAccountService_UnbindOpenAccount(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/cgi-bin/open/unbind", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"appid":"wxappid","open_appid":"wxopenappid"}`+"\n")
+		fmt.Fprint(w, `{"errcode": 0, "errmsg": "ok"}`)
+	})
+	_, err := client.Account.UnbindOpenAccount(context.Background(), "token", "wxappid", "wxopenappid")
+	if err != nil {
+		t.Errorf("Account.UnbindOpenAccount returned error: %v", err)
+	}
+}
+
+func TestAccountService_GetOpenAccount(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/cgi-bin/open/get", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"appid":"wxappid"}`+"\n")
+		fmt.Fprint(w, `{"errcode": 0, "errmsg": "ok", "open_appid": "wxopenappid"}`)
+	})
+	got, _, err := client.Account.GetOpenAccount(context.Background(), "token", "wxappid")
+	if err != nil {
+		t.Errorf("Account.GetOpenAccount returned error: %v", err)
+	}
+	want := &OpenAccount{OpenAppID: "wxopenappid"}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Account.GetOpenAccount returned %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,171 @@
+package wechat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// ErrModifyQuotaExhausted is returned when the head image or signature
+// cannot be modified anymore this month.
+var ErrModifyQuotaExhausted = errors.New("wechat: modify quota exhausted")
+
+// SetNicknameRequest represents request of set nickname. License is the
+// media id of the business license, IDCard the media id of the ID card for
+// personal accounts, NamingOtherStuff the media ids of other materials.
+type SetNicknameRequest struct {
+	NickName          string `json:"nick_name"`
+	IDCard            string `json:"id_card,omitempty"`
+	License           string `json:"license,omitempty"`
+	NamingOtherStuff1 string `json:"naming_other_stuff_1,omitempty"`
+	NamingOtherStuff2 string `json:"naming_other_stuff_2,omitempty"`
+	NamingOtherStuff3 string `json:"naming_other_stuff_3,omitempty"`
+	NamingOtherStuff4 string `json:"naming_other_stuff_4,omitempty"`
+	NamingOtherStuff5 string `json:"naming_other_stuff_5,omitempty"`
+}
+
+// NicknameAudit represents set nickname response. AuditID is set when the
+// nickname needs an audit.
+type NicknameAudit struct {
+	Wording string `json:"wording"`
+	AuditID int64  `json:"audit_id"`
+}
+
+// SetNickname set the nickname of the mini program.
+// Wechat API docs:
+// https://developers.weixin.qq.com/doc/oplatform/Third-party_Platforms/Mini_Programs/Mini_Program_Basic_Info/setnickname.html
+func (s *AccountService) SetNickname(ctx context.Context, token string, r *SetNicknameRequest) (*NicknameAudit, *Response, error) {
+	u := fmt.Sprintf("wxa/setnickname?access_token=%v", token)
+	req, err := s.client.NewRequest(http.MethodPost, u, r)
+	if err != nil {
+		return nil, nil, err
+	}
+	audit := new(NicknameAudit)
+	resp, err := s.client.Do(ctx, req, audit)
+	if err != nil {
+		return nil, resp, err
+	}
+	return audit, resp, nil
+}
+
+// NicknameAuditStatus represents the audit status of a nickname.
+type NicknameAuditStatus int
+
+// Nickname audit statuses.
+const (
+	NicknameAuditStatusAuditing NicknameAuditStatus = 1 // 审核中
+	NicknameAuditStatusRejected NicknameAuditStatus = 2 // 审核失败
+	NicknameAuditStatusApproved NicknameAuditStatus = 3 // 审核成功
+)
+
+// NicknameAuditResult represents query nickname response.
+type NicknameAuditResult struct {
+	Nickname   string              `json:"nickname"`
+	AuditStat  NicknameAuditStatus `json:"audit_stat"`
+	FailReason string              `json:"fail_reason"`
+	CreateTime int64               `json:"create_time"`
+	AuditTime  int64               `json:"audit_time"`
+}
+
+// QueryNickname fetch the audit result of a nickname set by SetNickname.
+// Wechat API docs:
+// https://developers.weixin.qq.com/doc/oplatform/Third-party_Platforms/Mini_Programs/Mini_Program_Basic_Info/api_wxa_querynickname.html
+func (s *AccountService) QueryNickname(ctx context.Context, token string, auditID int64) (*NicknameAuditResult, *Response, error) {
+	u := fmt.Sprintf("wxa/api_wxa_querynickname?access_token=%v", token)
+	payload := struct {
+		AuditID int64 `json:"audit_id"`
+	}{AuditID: auditID}
+	req, err := s.client.NewRequest(http.MethodPost, u, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+	result := new(NicknameAuditResult)
+	resp, err := s.client.Do(ctx, req, result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return result, resp, nil
+}
+
+// NicknameCheck represents check nickname response. HitCondition reports
+// whether the nickname needs materials, described by Wording.
+type NicknameCheck struct {
+	HitCondition bool   `json:"hit_condition"`
+	Wording      string `json:"wording"`
+}
+
+// CheckNickname check whether a nickname can be set without materials.
+// Wechat API docs:
+// https://developers.weixin.qq.com/doc/oplatform/Third-party_Platforms/Mini_Programs/Mini_Program_Basic_Info/wxverify_checknickname.html
+func (s *AccountService) CheckNickname(ctx context.Context, token, nickname string) (*NicknameCheck, *Response, error) {
+	u := fmt.Sprintf("cgi-bin/wxverify/checkwxverifynickname?access_token=%v", token)
+	payload := struct {
+		NickName string `json:"nick_name"`
+	}{NickName: nickname}
+	req, err := s.client.NewRequest(http.MethodPost, u, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+	check := new(NicknameCheck)
+	resp, err := s.client.Do(ctx, req, check)
+	if err != nil {
+		return nil, resp, err
+	}
+	return check, resp, nil
+}
+
+// ModifyHeadImageRequest represents request of modify head image. The crop
+// box (X1, Y1)-(X2, Y2) is relative to the image, between 0 and 1.
+type ModifyHeadImageRequest struct {
+	HeadImgMediaID string  `json:"head_img_media_id"`
+	X1             float64 `json:"x1"`
+	Y1             float64 `json:"y1"`
+	X2             float64 `json:"x2"`
+	Y2             float64 `json:"y2"`
+}
+
+// ModifyHeadImage modify the head image of the mini program. It checks the
+// quota reported by GetAccountBasicInfo first and returns
+// ErrModifyQuotaExhausted when it is used up.
+// Wechat API docs:
+// https://developers.weixin.qq.com/doc/oplatform/Third-party_Platforms/Mini_Programs/Mini_Program_Basic_Info/modifyheadimage.html
+func (s *AccountService) ModifyHeadImage(ctx context.Context, token string, r *ModifyHeadImageRequest) (*Response, error) {
+	info, resp, err := s.GetAccountBasicInfo(ctx, token)
+	if err != nil {
+		return resp, err
+	}
+	if q := info.HeadImageInfo; q != nil && q.ModifyUsedCount >= q.ModifyQuota {
+		return resp, ErrModifyQuotaExhausted
+	}
+	u := fmt.Sprintf("cgi-bin/account/modifyheadimage?access_token=%v", token)
+	req, err := s.client.NewRequest(http.MethodPost, u, r)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+// ModifySignature modify the signature of the mini program. It checks the
+// quota reported by GetAccountBasicInfo first and returns
+// ErrModifyQuotaExhausted when it is used up.
+// Wechat API docs:
+// https://developers.weixin.qq.com/doc/oplatform/Third-party_Platforms/Mini_Programs/Mini_Program_Basic_Info/modifysignature.html
+func (s *AccountService) ModifySignature(ctx context.Context, token, signature string) (*Response, error) {
+	info, resp, err := s.GetAccountBasicInfo(ctx, token)
+	if err != nil {
+		return resp, err
+	}
+	if q := info.SignatureInfo; q != nil && q.ModifyUsedCount >= q.ModifyQuota {
+		return resp, ErrModifyQuotaExhausted
+	}
+	u := fmt.Sprintf("cgi-bin/account/modifysignature?access_token=%v", token)
+	payload := struct {
+		Signature string `json:"signature"`
+	}{Signature: signature}
+	req, err := s.client.NewRequest(http.MethodPost, u, payload)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
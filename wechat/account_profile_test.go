@@ -0,0 +1,170 @@
+package wechat
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestAccountService_SetNickname(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/wxa/setnickname", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"nick_name":"昵称","license":"media_id"}`+"\n")
+		fmt.Fprint(w, `{"errcode": 0, "errmsg": "ok", "wording": "", "audit_id": 12345}`)
+	})
+	got, _, err := client.Account.SetNickname(context.Background(), "token", &SetNicknameRequest{
+		NickName: "昵称",
+		License:  "media_id",
+	})
+	if err != nil {
+		t.Errorf("Account.SetNickname returned error: %v", err)
+	}
+	want := &NicknameAudit{AuditID: 12345}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Account.SetNickname returned %+v, want %+v", got, want)
+	}
+}
+
+func TestAccountService_QueryNickname(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/wxa/api_wxa_querynickname", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"audit_id":12345}`+"\n")
+		fmt.Fprint(w, `{
+							  "errcode": 0,
+							  "errmsg": "ok",
+							  "nickname": "昵称",
+							  "audit_stat": 3,
+							  "fail_reason": "",
+							  "create_time": 1524588337,
+							  "audit_time": 1524588337
+							}`)
+	})
+	got, _, err := client.Account.QueryNickname(context.Background(), "token", 12345)
+	if err != nil {
+		t.Errorf("Account.QueryNickname returned error: %v", err)
+	}
+	want := &NicknameAuditResult{
+		Nickname:   "昵称",
+		AuditStat:  NicknameAuditStatusApproved,
+		CreateTime: 1524588337,
+		AuditTime:  1524588337,
+	}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Account.QueryNickname returned %+v, want %+v", got, want)
+	}
+}
+
+func TestAccountService_CheckNickname(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/cgi-bin/wxverify/checkwxverifynickname", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"nick_name":"微信"}`+"\n")
+		fmt.Fprint(w, `{"errcode": 0, "errmsg": "ok", "hit_condition": true, "wording": "请提供商标注册书"}`)
+	})
+	got, _, err := client.Account.CheckNickname(context.Background(), "token", "微信")
+	if err != nil {
+		t.Errorf("Account.CheckNickname returned error: %v", err)
+	}
+	want := &NicknameCheck{HitCondition: true, Wording: "请提供商标注册书"}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Account.CheckNickname returned %+v, want %+v", got, want)
+	}
+}
+
+func TestAccountService_ModifyHeadImage(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/cgi-bin/account/getaccountbasicinfo", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"head_image_info": {"modify_used_count": 1, "modify_quota": 5}}`)
+	})
+	mux.HandleFunc("/cgi-bin/account/modifyheadimage", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"head_img_media_id":"media_id","x1":0,"y1":0,"x2":0.7596899,"y2":0.98076925}`+"\n")
+		fmt.Fprint(w, `{"errcode": 0, "errmsg": "ok"}`)
+	})
+	_, err := client.Account.ModifyHeadImage(context.Background(), "token", &ModifyHeadImageRequest{
+		HeadImgMediaID: "media_id",
+		X2:             0.7596899,
+		Y2:             0.98076925,
+	})
+	if err != nil {
+		t.Errorf("Account.ModifyHeadImage returned error: %v", err)
+	}
+}
+
+func TestAccountService_ModifySignature(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/cgi-bin/account/getaccountbasicinfo", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"signature_info": {"signature": "old", "modify_used_count": 0, "modify_quota": 5}}`)
+	})
+	mux.HandleFunc("/cgi-bin/account/modifysignature", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"signature":"简介"}`+"\n")
+		fmt.Fprint(w, `{"errcode": 0, "errmsg": "ok"}`)
+	})
+	_, err := client.Account.ModifySignature(context.Background(), "token", "简介")
+	if err != nil {
+		t.Errorf("Account.ModifySignature returned error: %v", err)
+	}
+}
+
+func TestAccountService_ModifyProfile_quotaExhausted(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/cgi-bin/account/getaccountbasicinfo", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{
+							"signature_info": {"modify_used_count": 5, "modify_quota": 5},
+							"head_image_info": {"modify_used_count": 5, "modify_quota": 5}
+						}`)
+	})
+	mux.HandleFunc("/cgi-bin/account/modifysignature", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Account.ModifySignature sent the request with no quota left")
+	})
+	mux.HandleFunc("/cgi-bin/account/modifyheadimage", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Account.ModifyHeadImage sent the request with no quota left")
+	})
+	if _, err := client.Account.ModifySignature(context.Background(), "token", "简介"); err != ErrModifyQuotaExhausted {
+		t.Errorf("Account.ModifySignature err = %v, want %v", err, ErrModifyQuotaExhausted)
+	}
+	if _, err := client.Account.ModifyHeadImage(context.Background(), "token", &ModifyHeadImageRequest{}); err != ErrModifyQuotaExhausted {
+		t.Errorf("Account.ModifyHeadImage err = %v, want %v", err, ErrModifyQuotaExhausted)
+	}
+}
+
+func TestAccountService_ModifyProfile_zeroQuota(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/cgi-bin/account/getaccountbasicinfo", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{
+							"signature_info": {"modify_used_count": 0, "modify_quota": 0},
+							"head_image_info": {"modify_used_count": 0, "modify_quota": 0}
+						}`)
+	})
+	mux.HandleFunc("/cgi-bin/account/modifysignature", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Account.ModifySignature sent the request with a zero quota")
+	})
+	mux.HandleFunc("/cgi-bin/account/modifyheadimage", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Account.ModifyHeadImage sent the request with a zero quota")
+	})
+	if _, err := client.Account.ModifySignature(context.Background(), "token", "简介"); err != ErrModifyQuotaExhausted {
+		t.Errorf("Account.ModifySignature err = %v, want %v", err, ErrModifyQuotaExhausted)
+	}
+	if _, err := client.Account.ModifyHeadImage(context.Background(), "token", &ModifyHeadImageRequest{}); err != ErrModifyQuotaExhausted {
+		t.Errorf("Account.ModifyHeadImage err = %v, want %v", err, ErrModifyQuotaExhausted)
+	}
+}
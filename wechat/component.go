@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
 )
 
 // ComponentService Wechat API docs: https://developers.weixin.qq.com/doc/
@@ -62,3 +63,90 @@ func (s *ComponentService) FastRegisterWeApp(ctx context.Context, token string,
 	}
 	return s.client.Do(ctx, req, nil)
 }
+
+// fastRegisterAuthURL is the page where the administrator of an Official
+// Account authorizes to register a mini program from it.
+const fastRegisterAuthURL = "https://mp.weixin.qq.com/cgi-bin/fastregisterauth"
+
+// FastRegisterAuthURL returns the URL of the page where the administrator of
+// the Official Account appID authorizes to register a mini program reusing
+// its verification when copyWXVerify. The page redirects to redirectURI with
+// the ticket to pass to FastRegister.
+//
+// Wechat API docs:
+// https://developers.weixin.qq.com/doc/oplatform/Third-party_Platforms/Official_Accounts/fast_registration_of_mini_program.html
+func (s *ComponentService) FastRegisterAuthURL(appID, componentAppID string, copyWXVerify bool, redirectURI string) string {
+	q := url.Values{}
+	q.Set("appid", appID)
+	q.Set("component_appid", componentAppID)
+	q.Set("copy_wx_verify", boolFlag(copyWXVerify))
+	q.Set("redirect_uri", redirectURI)
+	return fastRegisterAuthURL + "?" + q.Encode()
+}
+
+// FastRegisteredWeApp represents fast register response.
+type FastRegisteredWeApp struct {
+	AppID             string `json:"appid"`
+	AuthorizationCode string `json:"authorization_code"`
+	IsWXVerifySucc    bool   `json:"is_wx_verify_succ"`
+	IsLinkSucc        bool   `json:"is_link_succ"`
+}
+
+// FastRegister register a mini program from an Official Account, token is
+// the access token of the Official Account and ticket comes from the page of
+// FastRegisterAuthURL. The authorization code of the new mini program can be
+// exchanged for its authorizer token.
+//
+// Wechat API docs:
+// https://developers.weixin.qq.com/doc/oplatform/Third-party_Platforms/Official_Accounts/fast_registration_of_mini_program.html
+func (s *ComponentService) FastRegister(ctx context.Context, token, ticket string) (*FastRegisteredWeApp, *Response, error) {
+	u := fmt.Sprintf("cgi-bin/account/fastregister?access_token=%v", token)
+	payload := struct {
+		Ticket string `json:"ticket"`
+	}{Ticket: ticket}
+	req, err := s.client.NewRequest(http.MethodPost, u, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+	app := new(FastRegisteredWeApp)
+	resp, err := s.client.Do(ctx, req, app)
+	if err != nil {
+		return nil, resp, err
+	}
+	return app, resp, nil
+}
+
+// componentRebindAdminURL is the page where the administrator of a mini
+// program confirms changing the administrator.
+const componentRebindAdminURL = "https://mp.weixin.qq.com/wxopen/componentrebindadmin"
+
+// RebindAdminURL returns the URL of the page where the administrator of the
+// mini program appID confirms changing the administrator. The page redirects
+// to redirectURI with the taskid to pass to RebindAdmin.
+//
+// Wechat API docs:
+// https://developers.weixin.qq.com/doc/oplatform/Third-party_Platforms/Mini_Programs/Admin.html
+func (s *ComponentService) RebindAdminURL(appID, componentAppID, redirectURI string) string {
+	q := url.Values{}
+	q.Set("appid", appID)
+	q.Set("component_appid", componentAppID)
+	q.Set("redirect_uri", redirectURI)
+	return componentRebindAdminURL + "?" + q.Encode()
+}
+
+// RebindAdmin change the administrator of a mini program, token is the
+// component access token and taskID comes from the page of RebindAdminURL.
+//
+// Wechat API docs:
+// https://developers.weixin.qq.com/doc/oplatform/Third-party_Platforms/Mini_Programs/Admin.html
+func (s *ComponentService) RebindAdmin(ctx context.Context, token, taskID string) (*Response, error) {
+	u := fmt.Sprintf("cgi-bin/account/componentrebindadmin?access_token=%v", token)
+	payload := struct {
+		TaskID string `json:"taskid"`
+	}{TaskID: taskID}
+	req, err := s.client.NewRequest(http.MethodPost, u, payload)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,156 @@
+package wechat
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// ModifyWXAServerDomainRequest represents request of modify the third-party
+// platform server domains. Domains are separated by ";".
+type ModifyWXAServerDomainRequest struct {
+	Action                    DomainAction `json:"action"`
+	WXAServerDomain           string       `json:"wxa_server_domain,omitempty"`
+	IsModifyPublishedTogether bool         `json:"is_modify_published_together,omitempty"`
+}
+
+// WXAServerDomain represents the third-party platform server domains, they are
+// separated by ";".
+type WXAServerDomain struct {
+	PublishedWXAServerDomain string `json:"published_wxa_server_domain"`
+	TestingWXAServerDomain   string `json:"testing_wxa_server_domain"`
+	InvalidWXAServerDomain   string `json:"invalid_wxa_server_domain"`
+}
+
+// ModifyWXAServerDomain modify the server domains of the third-party platform.
+//
+// Wechat API docs:
+// https://developers.weixin.qq.com/doc/oplatform/Third-party_Platforms/2.0/api/ThirdParty/domain/modify_server_domain.html
+func (s *ComponentService) ModifyWXAServerDomain(ctx context.Context, token string, r *ModifyWXAServerDomainRequest) (*WXAServerDomain, *Response, error) {
+	u := fmt.Sprintf("cgi-bin/component/modify_wxa_server_domain?access_token=%v", token)
+	req, err := s.client.NewRequest(http.MethodPost, u, r)
+	if err != nil {
+		return nil, nil, err
+	}
+	domain := new(WXAServerDomain)
+	resp, err := s.client.Do(ctx, req, domain)
+	if err != nil {
+		return nil, resp, err
+	}
+	return domain, resp, nil
+}
+
+// DomainConfirmFile represents the file to put under the jump domains to
+// verify them.
+type DomainConfirmFile struct {
+	FileName    string `json:"file_name"`
+	FileContent string `json:"file_content"`
+}
+
+// GetDomainConfirmFile fetch the jump domain confirm file.
+//
+// Wechat API docs:
+// https://developers.weixin.qq.com/doc/oplatform/Third-party_Platforms/2.0/api/ThirdParty/domain/get_domain_confirmfile.html
+func (s *ComponentService) GetDomainConfirmFile(ctx context.Context, token string) (*DomainConfirmFile, *Response, error) {
+	u := fmt.Sprintf("cgi-bin/component/get_domain_confirmfile?access_token=%v", token)
+	req, err := s.client.NewRequest(http.MethodPost, u, struct{}{})
+	if err != nil {
+		return nil, nil, err
+	}
+	file := new(DomainConfirmFile)
+	resp, err := s.client.Do(ctx, req, file)
+	if err != nil {
+		return nil, resp, err
+	}
+	return file, resp, nil
+}
+
+// ModifyWXAJumpDomainRequest represents request of modify the third-party
+// platform jump (business) domains. Domains are separated by ";".
+type ModifyWXAJumpDomainRequest struct {
+	Action                    DomainAction `json:"action"`
+	WXAJumpH5Domain           string       `json:"wxa_jump_h5_domain,omitempty"`
+	IsModifyPublishedTogether bool         `json:"is_modify_published_together,omitempty"`
+}
+
+// WXAJumpDomain represents the third-party platform jump domains, they are
+// separated by ";".
+type WXAJumpDomain struct {
+	PublishedWXAJumpH5Domain string `json:"published_wxa_jump_h5_domain"`
+	TestingWXAJumpH5Domain   string `json:"testing_wxa_jump_h5_domain"`
+	InvalidWXAJumpH5Domain   string `json:"invalid_wxa_jump_h5_domain"`
+}
+
+// ModifyWXAJumpDomain modify the jump domains of the third-party platform.
+//
+// Wechat API docs:
+// https://developers.weixin.qq.com/doc/oplatform/Third-party_Platforms/2.0/api/ThirdParty/domain/modify_jump_domain.html
+func (s *ComponentService) ModifyWXAJumpDomain(ctx context.Context, token string, r *ModifyWXAJumpDomainRequest) (*WXAJumpDomain, *Response, error) {
+	u := fmt.Sprintf("cgi-bin/component/modify_wxa_jump_domain?access_token=%v", token)
+	req, err := s.client.NewRequest(http.MethodPost, u, r)
+	if err != nil {
+		return nil, nil, err
+	}
+	domain := new(WXAJumpDomain)
+	resp, err := s.client.Do(ctx, req, domain)
+	if err != nil {
+		return nil, resp, err
+	}
+	return domain, resp, nil
+}
+
+// EffectiveDomain represents the server domains in effect for a mini program,
+// by where they are configured.
+type EffectiveDomain struct {
+	MPDomain        *Domain `json:"mp_domain"`
+	ThirdDomain     *Domain `json:"third_domain"`
+	DirectDomain    *Domain `json:"direct_domain"`
+	EffectiveDomain *Domain `json:"effective_domain"`
+}
+
+// GetEffectiveDomain fetch the server domains in effect for a mini program,
+// token is the authorizer access token.
+//
+// Wechat API docs:
+// https://developers.weixin.qq.com/doc/oplatform/Third-party_Platforms/2.0/api/Mini_Program_Basic_Info/get_effective_domain.html
+func (s *ComponentService) GetEffectiveDomain(ctx context.Context, token string) (*EffectiveDomain, *Response, error) {
+	u := fmt.Sprintf("wxa/get_effective_domain?access_token=%v", token)
+	req, err := s.client.NewRequest(http.MethodPost, u, struct{}{})
+	if err != nil {
+		return nil, nil, err
+	}
+	domain := new(EffectiveDomain)
+	resp, err := s.client.Do(ctx, req, domain)
+	if err != nil {
+		return nil, resp, err
+	}
+	return domain, resp, nil
+}
+
+// EffectiveWebViewDomain represents the web view domains in effect for a mini
+// program, by where they are configured.
+type EffectiveWebViewDomain struct {
+	MPWebViewDomain        []string `json:"mp_webviewdomain"`
+	ThirdWebViewDomain     []string `json:"third_webviewdomain"`
+	DirectWebViewDomain    []string `json:"direct_webviewdomain"`
+	EffectiveWebViewDomain []string `json:"effective_webviewdomain"`
+}
+
+// GetEffectiveWebViewDomain fetch the web view domains in effect for a mini
+// program, token is the authorizer access token.
+//
+// Wechat API docs:
+// https://developers.weixin.qq.com/doc/oplatform/Third-party_Platforms/2.0/api/Mini_Program_Basic_Info/get_effective_webviewdomain.html
+func (s *ComponentService) GetEffectiveWebViewDomain(ctx context.Context, token string) (*EffectiveWebViewDomain, *Response, error) {
+	u := fmt.Sprintf("wxa/get_effective_webviewdomain?access_token=%v", token)
+	req, err := s.client.NewRequest(http.MethodPost, u, struct{}{})
+	if err != nil {
+		return nil, nil, err
+	}
+	domain := new(EffectiveWebViewDomain)
+	resp, err := s.client.Do(ctx, req, domain)
+	if err != nil {
+		return nil, resp, err
+	}
+	return domain, resp, nil
+}
@@ -0,0 +1,159 @@
+package wechat
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestComponentService_ModifyWXAServerDomain(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/cgi-bin/component/modify_wxa_server_domain", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		body, _ := ioutil.ReadAll(r.Body)
+		if got, want := string(body), `{"action":"add","wxa_server_domain":"a.com;b.com"}`+"\n"; got != want {
+			t.Errorf("Request body = %v, want %v", got, want)
+		}
+		fmt.Fprint(w, `{
+							  "errcode": 0,
+							  "errmsg": "ok",
+							  "published_wxa_server_domain": "a.com",
+							  "testing_wxa_server_domain": "a.com;b.com",
+							  "invalid_wxa_server_domain": ""
+							}`)
+	})
+	got, _, err := client.Component.ModifyWXAServerDomain(context.Background(), "token", &ModifyWXAServerDomainRequest{
+		Action:          DomainActionAdd,
+		WXAServerDomain: "a.com;b.com",
+	})
+	if err != nil {
+		t.Errorf("Component.ModifyWXAServerDomain returned error: %v", err)
+	}
+	want := &WXAServerDomain{
+		PublishedWXAServerDomain: "a.com",
+		TestingWXAServerDomain:   "a.com;b.com",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Component.ModifyWXAServerDomain returned %+v, want %+v", got, want)
+	}
+}
+
+func TestComponentService_GetDomainConfirmFile(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/cgi-bin/component/get_domain_confirmfile", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprint(w, `{
+							  "errcode": 0,
+							  "errmsg": "ok",
+							  "file_name": "SDFSDFSDF.txt",
+							  "file_content": "SDFWEFWEFWEFWE"
+							}`)
+	})
+	got, _, err := client.Component.GetDomainConfirmFile(context.Background(), "token")
+	if err != nil {
+		t.Errorf("Component.GetDomainConfirmFile returned error: %v", err)
+	}
+	want := &DomainConfirmFile{FileName: "SDFSDFSDF.txt", FileContent: "SDFWEFWEFWEFWE"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Component.GetDomainConfirmFile returned %+v, want %+v", got, want)
+	}
+}
+
+func TestComponentService_ModifyWXAJumpDomain(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/cgi-bin/component/modify_wxa_jump_domain", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprint(w, `{
+							  "errcode": 0,
+							  "errmsg": "ok",
+							  "published_wxa_jump_h5_domain": "a.com",
+							  "testing_wxa_jump_h5_domain": "a.com",
+							  "invalid_wxa_jump_h5_domain": "c.com"
+							}`)
+	})
+	got, _, err := client.Component.ModifyWXAJumpDomain(context.Background(), "token", &ModifyWXAJumpDomainRequest{
+		Action:                    DomainActionSet,
+		WXAJumpH5Domain:           "a.com;c.com",
+		IsModifyPublishedTogether: true,
+	})
+	if err != nil {
+		t.Errorf("Component.ModifyWXAJumpDomain returned error: %v", err)
+	}
+	want := &WXAJumpDomain{
+		PublishedWXAJumpH5Domain: "a.com",
+		TestingWXAJumpH5Domain:   "a.com",
+		InvalidWXAJumpH5Domain:   "c.com",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Component.ModifyWXAJumpDomain returned %+v, want %+v", got, want)
+	}
+}
+
+func TestComponentService_GetEffectiveDomain(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/wxa/get_effective_domain", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprint(w, `{
+							  "errcode": 0,
+							  "errmsg": "ok",
+							  "mp_domain": {"requestdomain": ["https://a.com"]},
+							  "third_domain": {"requestdomain": ["https://b.com"]},
+							  "direct_domain": {},
+							  "effective_domain": {"requestdomain": ["https://a.com", "https://b.com"]}
+							}`)
+	})
+	got, _, err := client.Component.GetEffectiveDomain(context.Background(), "token")
+	if err != nil {
+		t.Errorf("Component.GetEffectiveDomain returned error: %v", err)
+	}
+	want := &EffectiveDomain{
+		MPDomain:        &Domain{RequestDomain: []string{"https://a.com"}},
+		ThirdDomain:     &Domain{RequestDomain: []string{"https://b.com"}},
+		DirectDomain:    &Domain{},
+		EffectiveDomain: &Domain{RequestDomain: []string{"https://a.com", "https://b.com"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Component.GetEffectiveDomain returned %+v, want %+v", got, want)
+	}
+}
+
+func TestComponentService_GetEffectiveWebViewDomain(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/wxa/get_effective_webviewdomain", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprint(w, `{
+							  "errcode": 0,
+							  "errmsg": "ok",
+							  "mp_webviewdomain": ["https://a.com"],
+							  "third_webviewdomain": [],
+							  "direct_webviewdomain": [],
+							  "effective_webviewdomain": ["https://a.com"]
+							}`)
+	})
+	got, _, err := client.Component.GetEffectiveWebViewDomain(context.Background(), "token")
+	if err != nil {
+		t.Errorf("Component.GetEffectiveWebViewDomain returned error: %v", err)
+	}
+	want := &EffectiveWebViewDomain{
+		MPWebViewDomain:        []string{"https://a.com"},
+		ThirdWebViewDomain:     []string{},
+		DirectWebViewDomain:    []string{},
+		EffectiveWebViewDomain: []string{"https://a.com"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Component.GetEffectiveWebViewDomain returned %+v, want %+v", got, want)
+	}
+}
@@ -62,3 +62,71 @@ func TestComponentService_CreateMiniProgram(t *testing.T) {
 		t.Errorf("Component.FastRegisterWeApp returend error: %v", err)
 	}
 }
+
+func TestComponentService_FastRegisterAuthURL(t *testing.T) {
+	client, _, _, tearDown := setup()
+	defer tearDown()
+
+	got := client.Component.FastRegisterAuthURL("wxmpappid", "wxcomponentappid", true, "https://example.com/callback?a=1")
+	want := "https://mp.weixin.qq.com/cgi-bin/fastregisterauth?appid=wxmpappid&component_appid=wxcomponentappid&copy_wx_verify=1&redirect_uri=https%3A%2F%2Fexample.com%2Fcallback%3Fa%3D1"
+	if got != want {
+		t.Errorf("Component.FastRegisterAuthURL returned %s, want %s", got, want)
+	}
+}
+
+func TestComponentService_FastRegister(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/cgi-bin/account/fastregister", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"ticket":"ticket"}`+"\n")
+		fmt.Fprint(w, `{
+							  "errcode": 0,
+							  "errmsg": "ok",
+							  "appid": "wxnewappid",
+							  "authorization_code": "authorization_code",
+							  "is_wx_verify_succ": true,
+							  "is_link_succ": true
+							}`)
+	})
+	got, _, err := client.Component.FastRegister(context.Background(), "token", "ticket")
+	if err != nil {
+		t.Errorf("Component.FastRegister returned error: %v", err)
+	}
+	want := &FastRegisteredWeApp{
+		AppID:             "wxnewappid",
+		AuthorizationCode: "authorization_code",
+		IsWXVerifySucc:    true,
+		IsLinkSucc:        true,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Component.FastRegister returned %+v, want %+v", got, want)
+	}
+}
+
+func TestComponentService_RebindAdminURL(t *testing.T) {
+	client, _, _, tearDown := setup()
+	defer tearDown()
+
+	got := client.Component.RebindAdminURL("wxappid", "wxcomponentappid", "https://example.com/callback")
+	want := "https://mp.weixin.qq.com/wxopen/componentrebindadmin?appid=wxappid&component_appid=wxcomponentappid&redirect_uri=https%3A%2F%2Fexample.com%2Fcallback"
+	if got != want {
+		t.Errorf("Component.RebindAdminURL returned %s, want %s", got, want)
+	}
+}
+
+func TestComponentService_RebindAdmin(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/cgi-bin/account/componentrebindadmin", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"taskid":"taskid"}`+"\n")
+		fmt.Fprint(w, `{"errcode": 0, "errmsg": "ok"}`)
+	})
+	_, err := client.Component.RebindAdmin(context.Background(), "token", "taskid")
+	if err != nil {
+		t.Errorf("Component.RebindAdmin returned error: %v", err)
+	}
+}
@@ -0,0 +1,40 @@
+package wechat
+
+import "context"
+
+// pageIterator walks the items of a paginated API page by page. It is the
+// engine behind the typed iterators of this package.
+type pageIterator struct {
+	ctx context.Context
+	// fetch returns the next page of items and whether more pages follow.
+	fetch func(ctx context.Context) (items []interface{}, more bool, err error)
+
+	items []interface{}
+	cur   interface{}
+	more  bool
+	err   error
+}
+
+func newPageIterator(ctx context.Context, fetch func(ctx context.Context) ([]interface{}, bool, error)) *pageIterator {
+	return &pageIterator{ctx: ctx, fetch: fetch, more: true}
+}
+
+// next advances to the next item, fetching the next page if needed. It
+// returns false when there are no more items or an error occurred.
+func (it *pageIterator) next() bool {
+	for len(it.items) == 0 {
+		if it.err != nil || !it.more {
+			return false
+		}
+		if err := it.ctx.Err(); err != nil {
+			it.err = err
+			return false
+		}
+		it.items, it.more, it.err = it.fetch(it.ctx)
+		if len(it.items) == 0 {
+			it.more = false
+		}
+	}
+	it.cur, it.items = it.items[0], it.items[1:]
+	return true
+}
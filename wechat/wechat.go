@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"mime/multipart"
 	"net/http"
 	"net/url"
 	"sync"
@@ -93,6 +94,41 @@ func (c *Client) NewRequest(method, urlStr string, body interface{}) (*http.Requ
 	return req, nil
 }
 
+// NewUploadRequest creates a multipart upload request. A relative URL can be
+// provided in urlStr, in which case it is resolved relative to the BaseURL of
+// the Client. The content read from reader is sent as the form file named
+// fieldName.
+func (c *Client) NewUploadRequest(urlStr, fieldName, fileName string, reader io.Reader) (*http.Request, error) {
+	u, err := c.BaseURL.Parse(urlStr)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := &bytes.Buffer{}
+	w := multipart.NewWriter(buf)
+	part, err := w.CreateFormFile(fieldName, fileName)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.Copy(part, reader); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, u.String(), buf)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", w.FormDataContentType())
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+	return req, nil
+}
+
 type service struct {
 	client *Client
 }
@@ -191,3 +227,12 @@ func (r *ErrorResponse) Error() string {
 		r.Response.Request.Method, r.Response.Request.URL,
 		r.Response.StatusCode, r.Message, r.Code)
 }
+
+// errorCode returns the errcode of err if it is an *ErrorResponse, or 0.
+func errorCode(err error) int {
+	var e *ErrorResponse
+	if errors.As(err, &e) {
+		return e.Code
+	}
+	return 0
+}
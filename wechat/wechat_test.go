@@ -31,6 +31,17 @@ func testMethod(t *testing.T, r *http.Request, want string) {
 	}
 }
 
+func testBody(t *testing.T, r *http.Request, want string) {
+	t.Helper()
+	b, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		t.Errorf("Error reading request body: %v", err)
+	}
+	if got := string(b); got != want {
+		t.Errorf("request Body is %s, want %s", got, want)
+	}
+}
+
 func testURLParseError(t *testing.T, err error) {
 	t.Helper()
 	if err == nil {
@@ -213,3 +224,36 @@ func TestNewRequest_badURL(t *testing.T) {
 	_, err := c.NewRequest(http.MethodGet, ":", nil)
 	testURLParseError(t, err)
 }
+
+func TestNewUploadRequest(t *testing.T) {
+	c := NewClient(nil)
+
+	req, err := c.NewUploadRequest("/foo", "file", "a.txt", strings.NewReader("hello"))
+	if err != nil {
+		t.Fatalf("NewUploadRequest returned error: %v", err)
+	}
+	if got, want := req.URL.String(), defaultBaseURL+"foo"; got != want {
+		t.Errorf("NewUploadRequest URL is %v, want %v", got, want)
+	}
+	if err := req.ParseMultipartForm(1 << 20); err != nil {
+		t.Fatalf("ParseMultipartForm returned error: %v", err)
+	}
+	f, h, err := req.FormFile("file")
+	if err != nil {
+		t.Fatalf("FormFile returned error: %v", err)
+	}
+	defer f.Close()
+	content, _ := ioutil.ReadAll(f)
+	if got, want := string(content), "hello"; got != want {
+		t.Errorf("NewUploadRequest file content is %v, want %v", got, want)
+	}
+	if got, want := h.Filename, "a.txt"; got != want {
+		t.Errorf("NewUploadRequest file name is %v, want %v", got, want)
+	}
+}
+
+func TestNewUploadRequest_badURL(t *testing.T) {
+	c := NewClient(nil)
+	_, err := c.NewUploadRequest(":", "file", "a.txt", strings.NewReader(""))
+	testURLParseError(t, err)
+}
@@ -0,0 +1,164 @@
+package wechat
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ErrAuditQuotaExhausted is returned by AuditGuard.Submit when no audit
+// quota is left.
+var ErrAuditQuotaExhausted = errors.New("wechat: audit quota exhausted")
+
+// SpeedupPolicy decides whether a submitted audit is sped up.
+type SpeedupPolicy struct {
+	// Reserve is the number of speedups kept for manual use, they are
+	// never spent by the guard.
+	Reserve int
+	// Match reports whether a submission deserves a speedup. A nil Match
+	// speeds up every submission while speedups are left.
+	Match func(r *SubmitAuditRequest) bool
+}
+
+// AuditSubmission represents the result of AuditGuard.Submit.
+type AuditSubmission struct {
+	Audit *Audit
+	// SpedUp is true if SpeedupAudit was called successfully.
+	SpedUp bool
+	// SpeedupErr is the error of SpeedupAudit, the audit itself has been
+	// submitted anyway.
+	SpeedupErr error
+}
+
+// AuditGuard checks the audit quota before submitting audits. The quota is
+// shared by all the authorizers of a third-party platform, so a single guard
+// should be shared by all the tenants of the process. Audits being submitted
+// are reserved in-process, so concurrent submissions do not overspend the
+// quota reported by QueryQuota.
+//
+// Submissions are refused with ErrAuditQuotaExhausted when the quota is
+// exhausted, they are not queued until the quota is renewed.
+type AuditGuard struct {
+	WXA *WXAService
+
+	// WaitInFlight makes Submit wait for in-flight submissions when the
+	// remaining quota is reserved by them, and check the quota again once
+	// they complete, instead of returning ErrAuditQuotaExhausted at once.
+	WaitInFlight bool
+
+	// Speedup is the speedup policy, a nil Speedup never speeds up.
+	Speedup *SpeedupPolicy
+
+	mu             sync.Mutex
+	pending        int
+	submitted      int // submissions completed, to account for those racing with QueryQuota
+	pendingSpeedup int
+	spedUp         int           // speedups completed, likewise
+	released       chan struct{} // closed when a reservation is released
+}
+
+// Submit submits the audit if quota is left, and then speeds it up according
+// to the speedup policy.
+func (g *AuditGuard) Submit(ctx context.Context, token string, r *SubmitAuditRequest) (*AuditSubmission, error) {
+	snap, err := g.reserve(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	audit, _, err := g.WXA.SubmitAudit(ctx, token, r)
+	g.mu.Lock()
+	g.pending--
+	if err == nil {
+		g.submitted++
+	}
+	g.notifyLocked()
+	g.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	sub := &AuditSubmission{Audit: audit}
+	if !g.reserveSpeedup(snap, r) {
+		return sub, nil
+	}
+	_, sub.SpeedupErr = g.WXA.SpeedupAudit(ctx, token, &SpeedupAuditRequest{AuditID: audit.AuditID})
+	sub.SpedUp = sub.SpeedupErr == nil
+	g.mu.Lock()
+	g.pendingSpeedup--
+	if sub.SpedUp {
+		g.spedUp++
+	}
+	g.mu.Unlock()
+	return sub, nil
+}
+
+// quotaSnapshot is a quota with the speedups completed when it was queried.
+type quotaSnapshot struct {
+	quota  *Quota
+	spedUp int
+}
+
+// reserve reserves an audit, waiting for in-flight submissions if needed.
+func (g *AuditGuard) reserve(ctx context.Context, token string) (*quotaSnapshot, error) {
+	for {
+		g.mu.Lock()
+		submitted, spedUp := g.submitted, g.spedUp
+		g.mu.Unlock()
+		quota, _, err := g.WXA.QueryQuota(ctx, token)
+		if err != nil {
+			return nil, err
+		}
+		g.mu.Lock()
+		// Submissions completed during QueryQuota may not be counted in
+		// quota.Rest yet, so they are counted as spent.
+		if quota.Rest-g.pending-(g.submitted-submitted) > 0 {
+			g.pending++
+			g.mu.Unlock()
+			return &quotaSnapshot{quota: quota, spedUp: spedUp}, nil
+		}
+		if g.submitted != submitted && g.pending == 0 {
+			// Query again, the quota is now up to date.
+			g.mu.Unlock()
+			continue
+		}
+		if !g.WaitInFlight || g.pending == 0 {
+			g.mu.Unlock()
+			return nil, ErrAuditQuotaExhausted
+		}
+		if g.released == nil {
+			g.released = make(chan struct{})
+		}
+		released := g.released
+		g.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-released:
+		}
+	}
+}
+
+// reserveSpeedup reserves a speedup if the policy allows it. Speedups
+// completed since snap was queried may not be counted in its SpeedupRest, so
+// they are counted as spent.
+func (g *AuditGuard) reserveSpeedup(snap *quotaSnapshot, r *SubmitAuditRequest) bool {
+	p := g.Speedup
+	if p == nil || (p.Match != nil && !p.Match(r)) {
+		return false
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if snap.quota.SpeedupRest-g.pendingSpeedup-(g.spedUp-snap.spedUp)-p.Reserve <= 0 {
+		return false
+	}
+	g.pendingSpeedup++
+	return true
+}
+
+// notifyLocked wakes up the waiting submissions. g.mu must be held.
+func (g *AuditGuard) notifyLocked() {
+	if g.released != nil {
+		close(g.released)
+		g.released = nil
+	}
+}
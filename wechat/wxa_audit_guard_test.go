@@ -0,0 +1,193 @@
+package wechat
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+)
+
+func TestAuditGuard_Submit(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	var speedups int32
+	mux.HandleFunc("/wxa/queryquota", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"errcode": 0, "errmsg": "ok", "rest": 5, "limit": 20, "speedup_rest": 2, "speedup_limit": 2}`)
+	})
+	mux.HandleFunc("/wxa/submit_audit", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"errcode": 0, "errmsg": "ok", "auditid": 1234567}`)
+	})
+	mux.HandleFunc("/wxa/speedupaudit", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&speedups, 1)
+		fmt.Fprint(w, `{"errcode": 0, "errmsg": "ok"}`)
+	})
+
+	g := &AuditGuard{
+		WXA: client.WXA,
+		Speedup: &SpeedupPolicy{
+			Reserve: 1,
+			Match: func(r *SubmitAuditRequest) bool {
+				return r.VersionDescription == "urgent"
+			},
+		},
+	}
+	got, err := g.Submit(context.Background(), "token", &SubmitAuditRequest{VersionDescription: "urgent"})
+	if err != nil {
+		t.Fatalf("AuditGuard.Submit returned err: %v", err)
+	}
+	if got.Audit.AuditID != 1234567 || !got.SpedUp {
+		t.Errorf("AuditGuard.Submit got %+v, want audit 1234567 sped up", got)
+	}
+
+	got, err = g.Submit(context.Background(), "token", &SubmitAuditRequest{VersionDescription: "normal"})
+	if err != nil {
+		t.Fatalf("AuditGuard.Submit returned err: %v", err)
+	}
+	if got.SpedUp {
+		t.Errorf("AuditGuard.Submit sped up a submission not matching the policy")
+	}
+	if speedups != 1 {
+		t.Errorf("AuditGuard.Submit called SpeedupAudit %d times, want 1", speedups)
+	}
+}
+
+func TestAuditGuard_Submit_speedupReserve(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/wxa/queryquota", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"errcode": 0, "errmsg": "ok", "rest": 5, "speedup_rest": 1}`)
+	})
+	mux.HandleFunc("/wxa/submit_audit", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"errcode": 0, "errmsg": "ok", "auditid": 1}`)
+	})
+	mux.HandleFunc("/wxa/speedupaudit", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("SpeedupAudit should not be called when only reserved speedups are left")
+	})
+
+	g := &AuditGuard{WXA: client.WXA, Speedup: &SpeedupPolicy{Reserve: 1}}
+	got, err := g.Submit(context.Background(), "token", &SubmitAuditRequest{})
+	if err != nil {
+		t.Fatalf("AuditGuard.Submit returned err: %v", err)
+	}
+	if got.SpedUp {
+		t.Errorf("AuditGuard.Submit got %+v, want not sped up", got)
+	}
+}
+
+func TestAuditGuard_Submit_exhausted(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/wxa/queryquota", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"errcode": 0, "errmsg": "ok", "rest": 0, "limit": 20}`)
+	})
+	mux.HandleFunc("/wxa/submit_audit", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("SubmitAudit should not be called without quota")
+	})
+
+	g := &AuditGuard{WXA: client.WXA, WaitInFlight: true}
+	if _, err := g.Submit(context.Background(), "token", &SubmitAuditRequest{}); err != ErrAuditQuotaExhausted {
+		t.Errorf("AuditGuard.Submit returned err: %v, want %v", err, ErrAuditQuotaExhausted)
+	}
+}
+
+func TestAuditGuard_Submit_concurrent(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	var rest int32 = 1
+	entered, proceed := make(chan struct{}), make(chan struct{})
+	mux.HandleFunc("/wxa/queryquota", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"errcode": 0, "errmsg": "ok", "rest": %d}`, atomic.LoadInt32(&rest))
+	})
+	mux.HandleFunc("/wxa/submit_audit", func(w http.ResponseWriter, r *http.Request) {
+		close(entered)
+		<-proceed
+		atomic.StoreInt32(&rest, 0)
+		fmt.Fprint(w, `{"errcode": 0, "errmsg": "ok", "auditid": 1}`)
+	})
+
+	g := &AuditGuard{WXA: client.WXA}
+	done := make(chan error)
+	go func() {
+		_, err := g.Submit(context.Background(), "token", &SubmitAuditRequest{})
+		done <- err
+	}()
+	<-entered
+
+	// The only audit left is reserved by the in-flight submission.
+	if _, err := g.Submit(context.Background(), "token", &SubmitAuditRequest{}); err != ErrAuditQuotaExhausted {
+		t.Errorf("AuditGuard.Submit returned err: %v, want %v", err, ErrAuditQuotaExhausted)
+	}
+
+	// With WaitInFlight, the second submission waits for the first one and
+	// then sees the exhausted quota.
+	g.WaitInFlight = true
+	waited := make(chan error)
+	go func() {
+		_, err := g.Submit(context.Background(), "token", &SubmitAuditRequest{})
+		waited <- err
+	}()
+	close(proceed)
+	if err := <-done; err != nil {
+		t.Errorf("AuditGuard.Submit returned err: %v", err)
+	}
+	if err := <-waited; err != ErrAuditQuotaExhausted {
+		t.Errorf("AuditGuard.Submit returned err: %v, want %v", err, ErrAuditQuotaExhausted)
+	}
+}
+
+func TestAuditGuard_Submit_concurrentSpeedupReserve(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	var queries, speedups int32
+	bothQueried, aDone := make(chan struct{}), make(chan struct{})
+	mux.HandleFunc("/wxa/queryquota", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&queries, 1) == 2 {
+			close(bothQueried)
+		}
+		fmt.Fprint(w, `{"errcode": 0, "errmsg": "ok", "rest": 5, "speedup_rest": 2}`)
+	})
+	mux.HandleFunc("/wxa/submit_audit", func(w http.ResponseWriter, r *http.Request) {
+		req := new(SubmitAuditRequest)
+		json.NewDecoder(r.Body).Decode(req)
+		// Both tenants read the quota before a speeds up, b decides once a
+		// has completed.
+		if req.VersionDescription == "a" {
+			<-bothQueried
+		} else {
+			<-aDone
+		}
+		fmt.Fprint(w, `{"errcode": 0, "errmsg": "ok", "auditid": 1}`)
+	})
+	mux.HandleFunc("/wxa/speedupaudit", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&speedups, 1)
+		fmt.Fprint(w, `{"errcode": 0, "errmsg": "ok"}`)
+	})
+
+	g := &AuditGuard{WXA: client.WXA, Speedup: &SpeedupPolicy{Reserve: 1}}
+	results := make(chan *AuditSubmission, 2)
+	for _, desc := range []string{"a", "b"} {
+		desc := desc
+		go func() {
+			sub, err := g.Submit(context.Background(), "token", &SubmitAuditRequest{VersionDescription: desc})
+			if err != nil {
+				t.Errorf("AuditGuard.Submit returned err: %v", err)
+			}
+			if desc == "a" {
+				close(aDone)
+			}
+			results <- sub
+		}()
+	}
+	<-results
+	<-results
+	if speedups != 1 {
+		t.Errorf("AuditGuard.Submit called SpeedupAudit %d times, want 1 with 1 reserved", speedups)
+	}
+}
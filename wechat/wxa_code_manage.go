@@ -180,6 +180,89 @@ func (s *WXAService) RevertCodeRelease(ctx context.Context, token string) (*Resp
 	return s.client.Do(ctx, req, nil)
 }
 
+// HistoryVersion represents a released version which can be reverted to.
+type HistoryVersion struct {
+	AppVersion  int    `json:"app_version"`
+	UserVersion string `json:"user_version"`
+	UserDesc    string `json:"user_desc"`
+	CommitTime  int64  `json:"commit_time"`
+}
+
+// HistoryVersions represents a history version list.
+type HistoryVersions struct {
+	VersionList []*HistoryVersion `json:"version_list"`
+}
+
+// GetHistoryVersion fetch the versions which can be reverted to.
+//
+// Wechat API docs:
+// https://developers.weixin.qq.com/doc/oplatform/Third-party_Platforms/Mini_Programs/code/revertcoderelease.html
+func (s *WXAService) GetHistoryVersion(ctx context.Context, token string) (*HistoryVersions, *Response, error) {
+	u := fmt.Sprintf("wxa/revertcoderelease?access_token=%v&action=get_history_version", token)
+	req, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	versions := new(HistoryVersions)
+	resp, err := s.client.Do(ctx, req, versions)
+	if err != nil {
+		return nil, resp, err
+	}
+	return versions, resp, nil
+}
+
+// RevertCodeReleaseVersion revert code release to the given app version,
+// which is one of the versions returned by GetHistoryVersion.
+//
+// Wechat API docs:
+// https://developers.weixin.qq.com/doc/oplatform/Third-party_Platforms/Mini_Programs/code/revertcoderelease.html
+func (s *WXAService) RevertCodeReleaseVersion(ctx context.Context, token string, appVersion int) (*Response, error) {
+	u := fmt.Sprintf("wxa/revertcoderelease?access_token=%v&app_version=%d", token, appVersion)
+	req, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+// ExpVersionInfo represents the experience version info.
+type ExpVersionInfo struct {
+	ExpTime    int64  `json:"exp_time"`
+	ExpVersion string `json:"exp_version"`
+	ExpDesc    string `json:"exp_desc"`
+}
+
+// ReleaseVersionInfo represents the release version info.
+type ReleaseVersionInfo struct {
+	ReleaseTime    int64  `json:"release_time"`
+	ReleaseVersion string `json:"release_version"`
+	ReleaseDesc    string `json:"release_desc"`
+}
+
+// VersionInfo represents get version info response.
+type VersionInfo struct {
+	ExpInfo     *ExpVersionInfo     `json:"exp_info,omitempty"`
+	ReleaseInfo *ReleaseVersionInfo `json:"release_info,omitempty"`
+}
+
+// GetVersionInfo fetch the experience and release version info.
+//
+// Wechat API docs:
+// https://developers.weixin.qq.com/doc/oplatform/Third-party_Platforms/2.0/api/code/get_versioninfo.html
+func (s *WXAService) GetVersionInfo(ctx context.Context, token string) (*VersionInfo, *Response, error) {
+	u := fmt.Sprintf("wxa/getversioninfo?access_token=%v", token)
+	req, err := s.client.NewRequest(http.MethodPost, u, struct{}{})
+	if err != nil {
+		return nil, nil, err
+	}
+	info := new(VersionInfo)
+	resp, err := s.client.Do(ctx, req, info)
+	if err != nil {
+		return nil, resp, err
+	}
+	return info, resp, nil
+}
+
 // GrayReleaseRequest represents a request to grey release.
 type GrayReleaseRequest struct {
 	GrayPercentage int `json:"gray_percentage"`
@@ -241,6 +241,94 @@ func TestWXAService_RevertCodeRelease(t *testing.T) {
 	}
 }
 
+func TestWXAService_GetHistoryVersion(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/wxa/revertcoderelease", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if got, want := r.URL.Query().Get("action"), "get_history_version"; got != want {
+			t.Errorf("Request action = %v, want %v", got, want)
+		}
+		fmt.Fprint(w, `{
+							  "errcode": 0,
+							  "errmsg": "ok",
+							  "version_list": [
+								{
+								  "app_version": 1,
+								  "user_version": "1.0.0",
+								  "user_desc": "first",
+								  "commit_time": 1514558400
+								}
+							  ]
+							}`)
+	})
+	got, _, err := client.WXA.GetHistoryVersion(context.Background(), "token")
+	if err != nil {
+		t.Errorf("WXA.GetHistoryVersion retured err: %v", err)
+	}
+	want := &HistoryVersions{VersionList: []*HistoryVersion{
+		{AppVersion: 1, UserVersion: "1.0.0", UserDesc: "first", CommitTime: 1514558400},
+	}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("WXA.GetHistoryVersion got %+v, want %+v", got, want)
+	}
+}
+
+func TestWXAService_RevertCodeReleaseVersion(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/wxa/revertcoderelease", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if got, want := r.URL.Query().Get("app_version"), "3"; got != want {
+			t.Errorf("Request app_version = %v, want %v", got, want)
+		}
+		fmt.Fprint(w, `{
+							  "errcode": 0,
+							  "errmsg": "ok"
+							}`)
+	})
+	_, err := client.WXA.RevertCodeReleaseVersion(context.Background(), "token", 3)
+	if err != nil {
+		t.Errorf("WXA.RevertCodeReleaseVersion retured err: %v", err)
+	}
+}
+
+func TestWXAService_GetVersionInfo(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/wxa/getversioninfo", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprint(w, `{
+							  "errcode": 0,
+							  "errmsg": "ok",
+							  "exp_info": {
+								"exp_time": 1611125155,
+								"exp_version": "1.1.0",
+								"exp_desc": "trial"
+							  },
+							  "release_info": {
+								"release_time": 1610000000,
+								"release_version": "1.0.0",
+								"release_desc": "release"
+							  }
+							}`)
+	})
+	got, _, err := client.WXA.GetVersionInfo(context.Background(), "token")
+	if err != nil {
+		t.Errorf("WXA.GetVersionInfo retured err: %v", err)
+	}
+	want := &VersionInfo{
+		ExpInfo:     &ExpVersionInfo{ExpTime: 1611125155, ExpVersion: "1.1.0", ExpDesc: "trial"},
+		ReleaseInfo: &ReleaseVersionInfo{ReleaseTime: 1610000000, ReleaseVersion: "1.0.0", ReleaseDesc: "release"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("WXA.GetVersionInfo got %+v, want %+v", got, want)
+	}
+}
+
 func TestWXAService_RevertGrayRelease(t *testing.T) {
 	client, mux, _, tearDown := setup()
 	defer tearDown()
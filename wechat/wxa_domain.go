@@ -6,9 +6,20 @@ import (
 	"net/http"
 )
 
+// DomainAction represents the action of a domain modification.
+type DomainAction string
+
+// Domain actions.
+const (
+	DomainActionAdd    DomainAction = "add"
+	DomainActionDelete DomainAction = "delete"
+	DomainActionSet    DomainAction = "set"
+	DomainActionGet    DomainAction = "get"
+)
+
 // ModifyDomainRequest represents modify domain request.
 type ModifyDomainRequest struct {
-	Action          string   `json:"action"`
+	Action          string   `json:"action"` // a DomainAction
 	RequestDomain   []string `json:"requestdomain,omitempty"`
 	WSRequestDomain []string `json:"wsrequestdomain,omitempty"`
 	UploadDomain    []string `json:"uploaddomain,omitempty"`
@@ -21,6 +32,7 @@ type Domain struct {
 	WSRequestDomain []string `json:"wsrequestdomain,omitempty"`
 	UploadDomain    []string `json:"uploaddomain,omitempty"`
 	DownloadDomain  []string `json:"downloaddomain,omitempty"`
+	WebViewDomain   []string `json:"webviewdomain,omitempty"`
 }
 
 // ModifyDomain server address configuration.
@@ -42,7 +54,7 @@ func (s *WXAService) ModifyDomain(ctx context.Context, token string, r *ModifyDo
 
 // SetWebViewDomainRequest represents set web view domain request.
 type SetWebViewDomainRequest struct {
-	Action        string   `json:"action,omitempty"`
+	Action        string   `json:"action,omitempty"` // a DomainAction
 	WebViewDomain []string `json:"webviewdomain,omitempty"`
 }
 
@@ -57,3 +69,21 @@ func (s *WXAService) SetWebViewDomain(ctx context.Context, token string, r *SetW
 	}
 	return s.client.Do(ctx, req, nil)
 }
+
+// GetWebViewDomain fetch the web view domains, they are returned in
+// Domain.WebViewDomain.
+// Wechat API docs:
+// https://developers.weixin.qq.com/doc/oplatform/Third-party_Platforms/Mini_Programs/Server_Address_Configuration.html
+func (s *WXAService) GetWebViewDomain(ctx context.Context, token string) (*Domain, *Response, error) {
+	u := fmt.Sprintf("wxa/setwebviewdomain?access_token=%v", token)
+	req, err := s.client.NewRequest(http.MethodPost, u, &SetWebViewDomainRequest{Action: string(DomainActionGet)})
+	if err != nil {
+		return nil, nil, err
+	}
+	domain := new(Domain)
+	resp, err := s.client.Do(ctx, req, domain)
+	if err != nil {
+		return nil, resp, err
+	}
+	return domain, resp, nil
+}
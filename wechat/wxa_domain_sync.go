@@ -0,0 +1,264 @@
+package wechat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+)
+
+// domainKind describes a kind of domain of Domain.
+type domainKind struct {
+	name   string
+	scheme string
+	get    func(d *Domain) []string
+	change func(p *DomainPlan) *DomainChange
+}
+
+var domainKinds = []domainKind{
+	{"requestdomain", "https", func(d *Domain) []string { return d.RequestDomain }, func(p *DomainPlan) *DomainChange { return &p.RequestDomain }},
+	{"wsrequestdomain", "wss", func(d *Domain) []string { return d.WSRequestDomain }, func(p *DomainPlan) *DomainChange { return &p.WSRequestDomain }},
+	{"uploaddomain", "https", func(d *Domain) []string { return d.UploadDomain }, func(p *DomainPlan) *DomainChange { return &p.UploadDomain }},
+	{"downloaddomain", "https", func(d *Domain) []string { return d.DownloadDomain }, func(p *DomainPlan) *DomainChange { return &p.DownloadDomain }},
+	{"webviewdomain", "https", func(d *Domain) []string { return d.WebViewDomain }, func(p *DomainPlan) *DomainChange { return &p.WebViewDomain }},
+}
+
+// ValidateDomain checks the domains locally before they are sent to Wechat:
+// socket domains must use wss and the others https, and hosts must be
+// registrable domain names, without IP, port, path or query.
+func ValidateDomain(d *Domain) error {
+	var msgs []string
+	for _, kind := range domainKinds {
+		for _, domain := range kind.get(d) {
+			if err := validateDomain(domain, kind.scheme); err != nil {
+				msgs = append(msgs, fmt.Sprintf("%s %q: %v", kind.name, domain, err))
+			}
+		}
+	}
+	if len(msgs) > 0 {
+		return fmt.Errorf("wechat: invalid domains: %s", strings.Join(msgs, "; "))
+	}
+	return nil
+}
+
+func validateDomain(domain, scheme string) error {
+	u, err := url.Parse(domain)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != scheme {
+		return fmt.Errorf("scheme must be %s", scheme)
+	}
+	if u.User != nil || (u.Path != "" && u.Path != "/") || u.RawQuery != "" || u.Fragment != "" {
+		return errors.New("must not contain user info, path, query or fragment")
+	}
+	if u.Port() != "" {
+		return errors.New("must not contain a port")
+	}
+	host := u.Hostname()
+	if net.ParseIP(host) != nil {
+		return errors.New("must not be an IP address")
+	}
+	return validateHostname(host)
+}
+
+// validateHostname checks that host looks like a registrable (ICP filed)
+// domain name.
+func validateHostname(host string) error {
+	if len(host) == 0 || len(host) > 253 {
+		return errors.New("invalid host length")
+	}
+	labels := strings.Split(host, ".")
+	if len(labels) < 2 {
+		return errors.New("host must be a domain name with a top-level domain")
+	}
+	for _, label := range labels {
+		if len(label) == 0 || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return fmt.Errorf("invalid host label %q", label)
+		}
+		for _, c := range label {
+			if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-') {
+				return fmt.Errorf("invalid character %q in host", c)
+			}
+		}
+	}
+	tld := labels[len(labels)-1]
+	if !strings.HasPrefix(tld, "xn--") {
+		for _, c := range tld {
+			if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z') {
+				return fmt.Errorf("invalid top-level domain %q", tld)
+			}
+		}
+	}
+	return nil
+}
+
+// DomainChange represents the domains to add and to delete.
+type DomainChange struct {
+	Add    []string
+	Delete []string
+}
+
+func (c *DomainChange) empty() bool {
+	return len(c.Add) == 0 && len(c.Delete) == 0
+}
+
+// DomainPlan represents the changes needed to reach the desired domains.
+type DomainPlan struct {
+	RequestDomain   DomainChange
+	WSRequestDomain DomainChange
+	UploadDomain    DomainChange
+	DownloadDomain  DomainChange
+	WebViewDomain   DomainChange
+}
+
+// Empty reports whether the plan has no change.
+func (p *DomainPlan) Empty() bool {
+	for _, kind := range domainKinds {
+		if !kind.change(p).empty() {
+			return false
+		}
+	}
+	return true
+}
+
+// String returns the plan in a human readable form, for example:
+//
+//	requestdomain:
+//	  + https://a.example.com
+//	  - https://b.example.com
+func (p *DomainPlan) String() string {
+	if p.Empty() {
+		return "no changes\n"
+	}
+	var b strings.Builder
+	for _, kind := range domainKinds {
+		c := kind.change(p)
+		if c.empty() {
+			continue
+		}
+		fmt.Fprintf(&b, "%s:\n", kind.name)
+		for _, d := range c.Add {
+			fmt.Fprintf(&b, "  + %s\n", d)
+		}
+		for _, d := range c.Delete {
+			fmt.Fprintf(&b, "  - %s\n", d)
+		}
+	}
+	return b.String()
+}
+
+// PlanDomains computes the minimal changes from current to desired. A nil
+// list in desired leaves that kind of domain unmanaged, while an empty list
+// deletes all of them.
+func PlanDomains(current, desired *Domain) *DomainPlan {
+	plan := new(DomainPlan)
+	for _, kind := range domainKinds {
+		want := kind.get(desired)
+		if want == nil {
+			continue
+		}
+		c := kind.change(plan)
+		c.Add, c.Delete = diffDomains(kind.get(current), want)
+	}
+	return plan
+}
+
+func normalizeDomain(domain string) string {
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(domain)), "/")
+}
+
+// diffDomains compares the normalized domains. Deleted domains are the
+// current entries as they are, so that WeChat matches them.
+func diffDomains(current, desired []string) (add, del []string) {
+	have := make(map[string]bool, len(current))
+	for _, d := range current {
+		have[normalizeDomain(d)] = true
+	}
+	want := make(map[string]bool, len(desired))
+	for _, d := range desired {
+		n := normalizeDomain(d)
+		if !want[n] && !have[n] {
+			add = append(add, n)
+		}
+		want[n] = true
+	}
+	for _, d := range current {
+		if !want[normalizeDomain(d)] {
+			del = append(del, d)
+		}
+	}
+	return add, del
+}
+
+// SyncDomainsOptions represents options of SyncDomains.
+type SyncDomainsOptions struct {
+	// DryRun returns the plan without applying it.
+	DryRun bool
+}
+
+// SyncDomains validates desired, then adds and deletes the server and web
+// view domains so that they match desired. See PlanDomains for how nil lists
+// are handled. Domains are added before the stale ones are deleted.
+func (s *WXAService) SyncDomains(ctx context.Context, token string, desired *Domain, opts *SyncDomainsOptions) (*DomainPlan, error) {
+	if opts == nil {
+		opts = &SyncDomainsOptions{}
+	}
+	if err := ValidateDomain(desired); err != nil {
+		return nil, err
+	}
+	current, _, err := s.ModifyDomain(ctx, token, &ModifyDomainRequest{Action: string(DomainActionGet)})
+	if err != nil {
+		return nil, err
+	}
+	if desired.WebViewDomain != nil {
+		webView, _, err := s.GetWebViewDomain(ctx, token)
+		if err != nil {
+			return nil, err
+		}
+		current.WebViewDomain = webView.WebViewDomain
+	}
+
+	plan := PlanDomains(current, desired)
+	if opts.DryRun || plan.Empty() {
+		return plan, nil
+	}
+
+	add := &ModifyDomainRequest{
+		Action:          string(DomainActionAdd),
+		RequestDomain:   plan.RequestDomain.Add,
+		WSRequestDomain: plan.WSRequestDomain.Add,
+		UploadDomain:    plan.UploadDomain.Add,
+		DownloadDomain:  plan.DownloadDomain.Add,
+	}
+	del := &ModifyDomainRequest{
+		Action:          string(DomainActionDelete),
+		RequestDomain:   plan.RequestDomain.Delete,
+		WSRequestDomain: plan.WSRequestDomain.Delete,
+		UploadDomain:    plan.UploadDomain.Delete,
+		DownloadDomain:  plan.DownloadDomain.Delete,
+	}
+	for _, r := range []*ModifyDomainRequest{add, del} {
+		if len(r.RequestDomain)+len(r.WSRequestDomain)+len(r.UploadDomain)+len(r.DownloadDomain) == 0 {
+			continue
+		}
+		if _, _, err := s.ModifyDomain(ctx, token, r); err != nil {
+			return plan, err
+		}
+	}
+	if len(plan.WebViewDomain.Add) > 0 {
+		r := &SetWebViewDomainRequest{Action: string(DomainActionAdd), WebViewDomain: plan.WebViewDomain.Add}
+		if _, err := s.SetWebViewDomain(ctx, token, r); err != nil {
+			return plan, err
+		}
+	}
+	if len(plan.WebViewDomain.Delete) > 0 {
+		r := &SetWebViewDomainRequest{Action: string(DomainActionDelete), WebViewDomain: plan.WebViewDomain.Delete}
+		if _, err := s.SetWebViewDomain(ctx, token, r); err != nil {
+			return plan, err
+		}
+	}
+	return plan, nil
+}
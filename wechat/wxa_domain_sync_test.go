@@ -0,0 +1,151 @@
+package wechat
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestValidateDomain(t *testing.T) {
+	tests := []struct {
+		domain *Domain
+		valid  bool
+	}{
+		{&Domain{RequestDomain: []string{"https://api.example.com"}}, true},
+		{&Domain{WSRequestDomain: []string{"wss://ws.example.com"}}, true},
+		{&Domain{WebViewDomain: []string{"https://m.example.xn--fiqs8s"}}, true},
+		{&Domain{RequestDomain: []string{"http://api.example.com"}}, false},
+		{&Domain{WSRequestDomain: []string{"https://ws.example.com"}}, false},
+		{&Domain{UploadDomain: []string{"https://1.2.3.4"}}, false},
+		{&Domain{DownloadDomain: []string{"https://example.com:8443"}}, false},
+		{&Domain{RequestDomain: []string{"https://example.com/api"}}, false},
+		{&Domain{RequestDomain: []string{"https://localhost"}}, false},
+		{&Domain{RequestDomain: []string{"https://-bad.example.com"}}, false},
+		{&Domain{RequestDomain: []string{"https://example.123"}}, false},
+	}
+	for _, tt := range tests {
+		err := ValidateDomain(tt.domain)
+		if valid := err == nil; valid != tt.valid {
+			t.Errorf("ValidateDomain(%+v) returned %v, want valid %v", tt.domain, err, tt.valid)
+		}
+	}
+}
+
+func TestPlanDomains(t *testing.T) {
+	current := &Domain{
+		RequestDomain:   []string{"https://a.example.com", "https://b.example.com"},
+		WSRequestDomain: []string{"wss://ws.example.com"},
+		UploadDomain:    []string{"https://up.example.com"},
+	}
+	desired := &Domain{
+		RequestDomain: []string{"https://A.example.com/", "https://c.example.com"},
+		UploadDomain:  []string{},
+	}
+	got := PlanDomains(current, desired)
+	want := &DomainPlan{
+		RequestDomain: DomainChange{
+			Add:    []string{"https://c.example.com"},
+			Delete: []string{"https://b.example.com"},
+		},
+		UploadDomain: DomainChange{Delete: []string{"https://up.example.com"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("PlanDomains got %+v, want %+v", got, want)
+	}
+
+	wantString := "requestdomain:\n" +
+		"  + https://c.example.com\n" +
+		"  - https://b.example.com\n" +
+		"uploaddomain:\n" +
+		"  - https://up.example.com\n"
+	if got := got.String(); got != wantString {
+		t.Errorf("DomainPlan.String() = %q, want %q", got, wantString)
+	}
+	if got := new(DomainPlan).String(); got != "no changes\n" {
+		t.Errorf("DomainPlan.String() = %q, want %q", got, "no changes\n")
+	}
+}
+
+func TestPlanDomains_deleteOriginal(t *testing.T) {
+	current := &Domain{RequestDomain: []string{"https://A.example.com/", "https://b.example.com"}}
+	desired := &Domain{RequestDomain: []string{"https://b.example.com"}}
+	got := PlanDomains(current, desired)
+	want := &DomainPlan{RequestDomain: DomainChange{Delete: []string{"https://A.example.com/"}}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("PlanDomains got %+v, want %+v", got, want)
+	}
+}
+
+func TestWXAService_SyncDomains(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	var modified []*ModifyDomainRequest
+	var webViews []*SetWebViewDomainRequest
+	mux.HandleFunc("/wxa/modify_domain", func(w http.ResponseWriter, r *http.Request) {
+		req := new(ModifyDomainRequest)
+		json.NewDecoder(r.Body).Decode(req)
+		if req.Action == string(DomainActionGet) {
+			fmt.Fprint(w, `{"errcode": 0, "errmsg": "ok", "requestdomain": ["https://a.example.com"]}`)
+			return
+		}
+		modified = append(modified, req)
+		fmt.Fprint(w, `{"errcode": 0, "errmsg": "ok"}`)
+	})
+	mux.HandleFunc("/wxa/setwebviewdomain", func(w http.ResponseWriter, r *http.Request) {
+		req := new(SetWebViewDomainRequest)
+		json.NewDecoder(r.Body).Decode(req)
+		if req.Action == string(DomainActionGet) {
+			fmt.Fprint(w, `{"errcode": 0, "errmsg": "ok", "webviewdomain": ["https://m.example.com"]}`)
+			return
+		}
+		webViews = append(webViews, req)
+		fmt.Fprint(w, `{"errcode": 0, "errmsg": "ok"}`)
+	})
+
+	desired := &Domain{
+		RequestDomain: []string{"https://b.example.com"},
+		WebViewDomain: []string{"https://m.example.com"},
+	}
+	plan, err := client.WXA.SyncDomains(context.Background(), "token", desired, &SyncDomainsOptions{DryRun: true})
+	if err != nil {
+		t.Fatalf("WXA.SyncDomains returned err: %v", err)
+	}
+	if len(modified) != 0 || len(webViews) != 0 {
+		t.Errorf("WXA.SyncDomains dry-run modified domains: %+v %+v", modified, webViews)
+	}
+	wantPlan := &DomainPlan{RequestDomain: DomainChange{
+		Add:    []string{"https://b.example.com"},
+		Delete: []string{"https://a.example.com"},
+	}}
+	if !reflect.DeepEqual(plan, wantPlan) {
+		t.Errorf("WXA.SyncDomains got %+v, want %+v", plan, wantPlan)
+	}
+
+	if _, err := client.WXA.SyncDomains(context.Background(), "token", desired, nil); err != nil {
+		t.Fatalf("WXA.SyncDomains returned err: %v", err)
+	}
+	want := []*ModifyDomainRequest{
+		{Action: string(DomainActionAdd), RequestDomain: []string{"https://b.example.com"}},
+		{Action: string(DomainActionDelete), RequestDomain: []string{"https://a.example.com"}},
+	}
+	if !reflect.DeepEqual(modified, want) {
+		t.Errorf("WXA.SyncDomains modified %+v, want %+v", modified, want)
+	}
+	if len(webViews) != 0 {
+		t.Errorf("WXA.SyncDomains modified web view domains: %+v", webViews)
+	}
+}
+
+func TestWXAService_SyncDomains_invalid(t *testing.T) {
+	client, _, _, tearDown := setup()
+	defer tearDown()
+
+	_, err := client.WXA.SyncDomains(context.Background(), "token", &Domain{RequestDomain: []string{"http://1.2.3.4"}}, nil)
+	if err == nil {
+		t.Error("WXA.SyncDomains expected error for invalid domains")
+	}
+}
@@ -3,6 +3,7 @@ package wechat
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"reflect"
 	"testing"
@@ -63,3 +64,29 @@ func TestWXAService_SetWebViewDomain(t *testing.T) {
 		t.Errorf("WXA.SetWebViewDomain retured err: %v", err)
 	}
 }
+
+func TestWXAService_GetWebViewDomain(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/wxa/setwebviewdomain", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		body, _ := ioutil.ReadAll(r.Body)
+		if got, want := string(body), `{"action":"get"}`+"\n"; got != want {
+			t.Errorf("Request body = %v, want %v", got, want)
+		}
+		fmt.Fprint(w, `{
+							  "errcode": 0,
+							  "errmsg": "ok",
+							  "webviewdomain": ["https://www.qq.com"]
+							}`)
+	})
+	got, _, err := client.WXA.GetWebViewDomain(context.Background(), "token")
+	if err != nil {
+		t.Errorf("WXA.GetWebViewDomain retured err: %v", err)
+	}
+	want := &Domain{WebViewDomain: []string{"https://www.qq.com"}}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("WXA.GetWebViewDomain got %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,162 @@
+package wechat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Clock provides timers. It can be replaced to drive time dependent helpers,
+// such as GrayReleaseController, in tests.
+type Clock interface {
+	After(d time.Duration) <-chan time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+// HealthStatus represents the result of a gray release health check.
+type HealthStatus int
+
+// Gray release health statuses.
+const (
+	// HealthOK lets the rollout go on.
+	HealthOK HealthStatus = iota
+	// HealthPause holds the current stage until the check reports HealthOK again.
+	HealthPause
+	// HealthRollback reverts the gray release and stops the rollout.
+	HealthRollback
+)
+
+// GrayReleaseStage represents a stage of a staged rollout.
+type GrayReleaseStage struct {
+	// Percentage is the gray percentage of this stage, from 1 to 99.
+	Percentage int
+	// Duration is how long the stage must stay healthy before moving on.
+	Duration time.Duration
+}
+
+// HealthCheck reports the health of the version under gray release, for
+// example from the JS error rate of your own metrics. An error is handled as
+// HealthPause, so that the stage is held until the check succeeds again.
+type HealthCheck func(ctx context.Context, stage *GrayReleaseStage) (HealthStatus, error)
+
+// ErrGrayReleaseRolledBack is returned by GrayReleaseController.Run when the
+// health check asked for a rollback and the gray release has been reverted.
+var ErrGrayReleaseRolledBack = errors.New("wechat: gray release rolled back")
+
+const defaultGrayReleaseCheckInterval = time.Minute
+
+// GrayReleaseController runs a staged gray release, for example
+// 1% → 10% → 50% → full release, and reverts it when the health check fails.
+type GrayReleaseController struct {
+	WXA   *WXAService
+	Token string
+
+	// Stages are run in order, their percentages must be increasing. The
+	// code is fully released once the last stage is done. At least one
+	// stage is required.
+	Stages []*GrayReleaseStage
+
+	// HealthCheck is called every CheckInterval during a stage. A nil
+	// HealthCheck always reports HealthOK.
+	HealthCheck HealthCheck
+
+	// CheckInterval defaults to one minute.
+	CheckInterval time.Duration
+
+	// Clock defaults to the system clock.
+	Clock Clock
+}
+
+func (c *GrayReleaseController) clock() Clock {
+	if c.Clock == nil {
+		return systemClock{}
+	}
+	return c.Clock
+}
+
+func (c *GrayReleaseController) interval() time.Duration {
+	if c.CheckInterval <= 0 {
+		return defaultGrayReleaseCheckInterval
+	}
+	return c.CheckInterval
+}
+
+func (c *GrayReleaseController) validate() error {
+	if len(c.Stages) == 0 {
+		return errors.New("wechat: no gray release stages")
+	}
+	last := 0
+	for i, stage := range c.Stages {
+		if stage == nil {
+			return fmt.Errorf("wechat: invalid gray release stage %d: nil stage", i)
+		}
+		if stage.Percentage <= last || stage.Percentage >= 100 {
+			return fmt.Errorf("wechat: invalid gray release stage %d: percentage %d", i, stage.Percentage)
+		}
+		last = stage.Percentage
+	}
+	return nil
+}
+
+// Run runs the stages and then releases the code. It returns
+// ErrGrayReleaseRolledBack if the health check triggered a rollback, or
+// ctx.Err() if ctx is done before the rollout completes, in which case the
+// gray release is left as it is.
+func (c *GrayReleaseController) Run(ctx context.Context) error {
+	if err := c.validate(); err != nil {
+		return err
+	}
+	for _, stage := range c.Stages {
+		if _, err := c.WXA.GrayRelease(ctx, c.Token, &GrayReleaseRequest{GrayPercentage: stage.Percentage}); err != nil {
+			return err
+		}
+		if err := c.hold(ctx, stage); err != nil {
+			return err
+		}
+	}
+	_, err := c.WXA.Release(ctx, c.Token)
+	return err
+}
+
+// hold waits until the stage has been healthy for its whole duration.
+// Paused time does not count.
+func (c *GrayReleaseController) hold(ctx context.Context, stage *GrayReleaseStage) error {
+	clock := c.clock()
+	remaining := stage.Duration
+	for remaining > 0 {
+		wait := c.interval()
+		if wait > remaining {
+			wait = remaining
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-clock.After(wait):
+		}
+		switch c.check(ctx, stage) {
+		case HealthOK:
+			remaining -= wait
+		case HealthRollback:
+			if _, err := c.WXA.RevertGrayRelease(ctx, c.Token); err != nil {
+				return err
+			}
+			return ErrGrayReleaseRolledBack
+		}
+	}
+	return nil
+}
+
+func (c *GrayReleaseController) check(ctx context.Context, stage *GrayReleaseStage) HealthStatus {
+	if c.HealthCheck == nil {
+		return HealthOK
+	}
+	status, err := c.HealthCheck(ctx, stage)
+	if err != nil {
+		return HealthPause
+	}
+	return status
+}
@@ -0,0 +1,198 @@
+package wechat
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// fakeClock fires every timer immediately and records the waited durations.
+type fakeClock struct {
+	waited []time.Duration
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.waited = append(c.waited, d)
+	ch := make(chan time.Time, 1)
+	ch <- time.Time{}
+	return ch
+}
+
+// grayReleaseCalls registers the gray release endpoints and records the calls.
+func grayReleaseCalls(t *testing.T, mux *http.ServeMux) *[]string {
+	t.Helper()
+	calls := new([]string)
+	mux.HandleFunc("/wxa/grayrelease", func(w http.ResponseWriter, r *http.Request) {
+		req := new(GrayReleaseRequest)
+		json.NewDecoder(r.Body).Decode(req)
+		*calls = append(*calls, fmt.Sprintf("gray %d", req.GrayPercentage))
+		fmt.Fprint(w, `{"errcode": 0, "errmsg": "ok"}`)
+	})
+	mux.HandleFunc("/wxa/release", func(w http.ResponseWriter, r *http.Request) {
+		*calls = append(*calls, "release")
+		fmt.Fprint(w, `{"errcode": 0, "errmsg": "ok"}`)
+	})
+	mux.HandleFunc("/wxa/revertgrayrelease", func(w http.ResponseWriter, r *http.Request) {
+		*calls = append(*calls, "revert")
+		fmt.Fprint(w, `{"errcode": 0, "errmsg": "ok"}`)
+	})
+	return calls
+}
+
+func TestGrayReleaseController_Run(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+	calls := grayReleaseCalls(t, mux)
+
+	clock := new(fakeClock)
+	paused := false
+	c := &GrayReleaseController{
+		WXA:   client.WXA,
+		Token: "token",
+		Stages: []*GrayReleaseStage{
+			{Percentage: 1, Duration: time.Hour},
+			{Percentage: 10, Duration: 90 * time.Minute},
+			{Percentage: 50, Duration: time.Hour},
+		},
+		HealthCheck: func(ctx context.Context, stage *GrayReleaseStage) (HealthStatus, error) {
+			// Pause once during the second stage.
+			if stage.Percentage == 10 && !paused {
+				paused = true
+				return HealthPause, nil
+			}
+			return HealthOK, nil
+		},
+		CheckInterval: time.Hour,
+		Clock:         clock,
+	}
+	if err := c.Run(context.Background()); err != nil {
+		t.Fatalf("GrayReleaseController.Run returned err: %v", err)
+	}
+	want := []string{"gray 1", "gray 10", "gray 50", "release"}
+	if !reflect.DeepEqual(*calls, want) {
+		t.Errorf("GrayReleaseController.Run calls = %v, want %v", *calls, want)
+	}
+	wantWaited := []time.Duration{time.Hour, time.Hour, time.Hour, 30 * time.Minute, time.Hour}
+	if !reflect.DeepEqual(clock.waited, wantWaited) {
+		t.Errorf("GrayReleaseController.Run waited %v, want %v", clock.waited, wantWaited)
+	}
+}
+
+func TestGrayReleaseController_Run_rollback(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+	calls := grayReleaseCalls(t, mux)
+
+	c := &GrayReleaseController{
+		WXA:   client.WXA,
+		Token: "token",
+		Stages: []*GrayReleaseStage{
+			{Percentage: 1, Duration: time.Hour},
+			{Percentage: 10, Duration: time.Hour},
+		},
+		HealthCheck: func(ctx context.Context, stage *GrayReleaseStage) (HealthStatus, error) {
+			if stage.Percentage == 10 {
+				return HealthRollback, nil
+			}
+			return HealthOK, nil
+		},
+		Clock: new(fakeClock),
+	}
+	if err := c.Run(context.Background()); err != ErrGrayReleaseRolledBack {
+		t.Errorf("GrayReleaseController.Run returned err: %v, want %v", err, ErrGrayReleaseRolledBack)
+	}
+	want := []string{"gray 1", "gray 10", "revert"}
+	if !reflect.DeepEqual(*calls, want) {
+		t.Errorf("GrayReleaseController.Run calls = %v, want %v", *calls, want)
+	}
+}
+
+func TestGrayReleaseController_Run_checkError(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+	calls := grayReleaseCalls(t, mux)
+
+	clock := new(fakeClock)
+	failed := false
+	c := &GrayReleaseController{
+		WXA:    client.WXA,
+		Token:  "token",
+		Stages: []*GrayReleaseStage{{Percentage: 1, Duration: time.Hour}},
+		HealthCheck: func(ctx context.Context, stage *GrayReleaseStage) (HealthStatus, error) {
+			// Fail once, which holds the stage like HealthPause.
+			if !failed {
+				failed = true
+				return HealthOK, errors.New("metrics unavailable")
+			}
+			return HealthOK, nil
+		},
+		CheckInterval: time.Hour,
+		Clock:         clock,
+	}
+	if err := c.Run(context.Background()); err != nil {
+		t.Fatalf("GrayReleaseController.Run returned err: %v", err)
+	}
+	want := []string{"gray 1", "release"}
+	if !reflect.DeepEqual(*calls, want) {
+		t.Errorf("GrayReleaseController.Run calls = %v, want %v", *calls, want)
+	}
+	wantWaited := []time.Duration{time.Hour, time.Hour}
+	if !reflect.DeepEqual(clock.waited, wantWaited) {
+		t.Errorf("GrayReleaseController.Run waited %v, want %v", clock.waited, wantWaited)
+	}
+}
+
+func TestGrayReleaseController_Run_canceled(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+	calls := grayReleaseCalls(t, mux)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	c := &GrayReleaseController{
+		WXA:    client.WXA,
+		Token:  "token",
+		Stages: []*GrayReleaseStage{{Percentage: 1, Duration: time.Hour}},
+		HealthCheck: func(ctx context.Context, stage *GrayReleaseStage) (HealthStatus, error) {
+			cancel()
+			return HealthPause, nil
+		},
+		Clock: new(fakeClock),
+	}
+	if err := c.Run(ctx); err != context.Canceled {
+		t.Errorf("GrayReleaseController.Run returned err: %v, want %v", err, context.Canceled)
+	}
+	want := []string{"gray 1"}
+	if !reflect.DeepEqual(*calls, want) {
+		t.Errorf("GrayReleaseController.Run calls = %v, want %v", *calls, want)
+	}
+}
+
+func TestGrayReleaseController_Run_invalidStages(t *testing.T) {
+	c := &GrayReleaseController{
+		Stages: []*GrayReleaseStage{{Percentage: 10}, {Percentage: 5}},
+	}
+	if err := c.Run(context.Background()); err == nil {
+		t.Error("GrayReleaseController.Run expected error for decreasing percentages")
+	}
+}
+
+func TestGrayReleaseController_Run_noStages(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+	calls := grayReleaseCalls(t, mux)
+
+	for _, stages := range [][]*GrayReleaseStage{nil, {{Percentage: 1}, nil}} {
+		c := &GrayReleaseController{WXA: client.WXA, Token: "token", Stages: stages}
+		if err := c.Run(context.Background()); err == nil {
+			t.Errorf("GrayReleaseController.Run expected error for stages %v", stages)
+		}
+	}
+	if len(*calls) != 0 {
+		t.Errorf("GrayReleaseController.Run calls = %v, want none", *calls)
+	}
+}
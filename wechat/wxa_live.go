@@ -22,22 +22,46 @@ type LiveReplay struct {
 	MediaURL   string    `json:"media_url"`
 }
 
+// PriceType represents the price type of a good.
+type PriceType int
+
+// Price types.
+const (
+	PriceTypeFixed    PriceType = 1 // 一口价, uses Price
+	PriceTypeRange    PriceType = 2 // 价格区间, from Price to Price2
+	PriceTypeDiscount PriceType = 3 // 折扣价, Price is the original price and Price2 the discounted one
+)
+
 // Good represents business good
 type Good struct {
 	CoverImg  string `json:"cover_img"`
 	URL       string `json:"url"`
 	Price     int    `json:"price"`
 	Price2    int    `json:"price2"`
-	PriceType int    `json:"price_type"`
+	PriceType int    `json:"price_type"` // a PriceType
 	Name      string `json:"name"`
 }
 
+// LiveStatus represents the status of a live room.
+type LiveStatus int
+
+// Live statuses.
+const (
+	LiveStatusLive       LiveStatus = 101 // 直播中
+	LiveStatusNotStarted LiveStatus = 102 // 未开始
+	LiveStatusEnded      LiveStatus = 103 // 已结束
+	LiveStatusBanned     LiveStatus = 104 // 禁播
+	LiveStatusPaused     LiveStatus = 105 // 暂停
+	LiveStatusError      LiveStatus = 106 // 异常
+	LiveStatusExpired    LiveStatus = 107 // 已过期
+)
+
 // RoomInfo represents live room info
 type RoomInfo struct {
 	Name       string  `json:"name"`
 	RoomID     int     `json:"roomid"`
 	CoverImg   string  `json:"cover_img"`
-	LiveStatus int     `json:"live_status"`
+	LiveStatus int     `json:"live_status"` // a LiveStatus
 	StartTime  int64   `json:"start_time"`
 	EndTime    int64   `json:"end_time"`
 	AnchorName string  `json:"anchor_name"`
@@ -68,3 +92,74 @@ func (s *WXAService) GetLiveInfo(ctx context.Context, token string, r *GetLiveIn
 	}
 	return liveInfo, resp, nil
 }
+
+// maxLiveInfoLimit is the maximum page size of GetLiveInfo.
+const maxLiveInfoLimit = 100
+
+// RoomInfoIterator iterates over all the live rooms.
+type RoomInfoIterator struct {
+	it *pageIterator
+}
+
+// Next advances to the next room. It returns false when there are no more
+// rooms, an error occurred or the context is done.
+func (it *RoomInfoIterator) Next() bool { return it.it.next() }
+
+// Room returns the current room.
+func (it *RoomInfoIterator) Room() *RoomInfo { return it.it.cur.(*RoomInfo) }
+
+// Err returns the error which stopped the iteration, if any.
+func (it *RoomInfoIterator) Err() error { return it.it.err }
+
+// AllLiveRooms returns an iterator over all the live rooms, fetching the
+// maximum page size at a time.
+func (s *WXAService) AllLiveRooms(ctx context.Context, token string) *RoomInfoIterator {
+	start := 0
+	return &RoomInfoIterator{it: newPageIterator(ctx, func(ctx context.Context) ([]interface{}, bool, error) {
+		r := &GetLiveInfoRequest{Start: start, Limit: maxLiveInfoLimit}
+		info, _, err := s.GetLiveInfo(ctx, token, r)
+		if err != nil {
+			return nil, false, err
+		}
+		items := make([]interface{}, len(info.RoomInfo))
+		for i, room := range info.RoomInfo {
+			items[i] = room
+		}
+		start += len(info.RoomInfo)
+		return items, start < info.Total, nil
+	})}
+}
+
+// LiveReplayIterator iterates over all the replays of a live room.
+type LiveReplayIterator struct {
+	it *pageIterator
+}
+
+// Next advances to the next replay. It returns false when there are no more
+// replays, an error occurred or the context is done.
+func (it *LiveReplayIterator) Next() bool { return it.it.next() }
+
+// Replay returns the current replay.
+func (it *LiveReplayIterator) Replay() *LiveReplay { return it.it.cur.(*LiveReplay) }
+
+// Err returns the error which stopped the iteration, if any.
+func (it *LiveReplayIterator) Err() error { return it.it.err }
+
+// AllLiveReplays returns an iterator over all the replays of a live room,
+// fetching the maximum page size at a time.
+func (s *WXAService) AllLiveReplays(ctx context.Context, token string, roomID int) *LiveReplayIterator {
+	start := 0
+	return &LiveReplayIterator{it: newPageIterator(ctx, func(ctx context.Context) ([]interface{}, bool, error) {
+		r := &GetLiveInfoRequest{Action: "get_replay", RoomID: roomID, Start: start, Limit: maxLiveInfoLimit}
+		info, _, err := s.GetLiveInfo(ctx, token, r)
+		if err != nil {
+			return nil, false, err
+		}
+		items := make([]interface{}, len(info.LiveReplay))
+		for i, replay := range info.LiveReplay {
+			items[i] = replay
+		}
+		start += len(info.LiveReplay)
+		return items, start < info.Total, nil
+	})}
+}
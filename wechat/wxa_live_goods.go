@@ -0,0 +1,270 @@
+package wechat
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// GoodsAuditStatus represents the audit status of live goods.
+type GoodsAuditStatus int
+
+// Goods audit statuses.
+const (
+	GoodsAuditStatusUnaudited GoodsAuditStatus = 0 // 未审核
+	GoodsAuditStatusAuditing  GoodsAuditStatus = 1 // 审核中
+	GoodsAuditStatusApproved  GoodsAuditStatus = 2 // 审核通过
+	GoodsAuditStatusRejected  GoodsAuditStatus = 3 // 审核驳回
+)
+
+// GoodsInfo represents live goods to add or update. Prices are in yuan.
+type GoodsInfo struct {
+	GoodsID         int       `json:"goodsId,omitempty"`
+	CoverImgURL     string    `json:"coverImgUrl,omitempty"`
+	Name            string    `json:"name,omitempty"`
+	PriceType       PriceType `json:"priceType,omitempty"`
+	Price           float64   `json:"price,omitempty"`
+	Price2          float64   `json:"price2,omitempty"`
+	URL             string    `json:"url,omitempty"`
+	ThirdPartyAppID string    `json:"thirdPartyAppid,omitempty"`
+}
+
+// GoodsAudit represents the audit of live goods.
+type GoodsAudit struct {
+	GoodsID int `json:"goodsId,omitempty"`
+	AuditID int `json:"auditId"`
+}
+
+// AddGoods add goods to the goods library and submit them to audit.
+// Wechat API docs:
+// https://developers.weixin.qq.com/miniprogram/dev/platform-capabilities/industry/liveplayer/commodity-api.html
+func (s *WXAService) AddGoods(ctx context.Context, token string, info *GoodsInfo) (*GoodsAudit, *Response, error) {
+	u := fmt.Sprintf("wxaapi/broadcast/goods/add?access_token=%v", token)
+	payload := struct {
+		GoodsInfo *GoodsInfo `json:"goodsInfo"`
+	}{GoodsInfo: info}
+	req, err := s.client.NewRequest(http.MethodPost, u, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+	audit := new(GoodsAudit)
+	resp, err := s.client.Do(ctx, req, audit)
+	if err != nil {
+		return nil, resp, err
+	}
+	return audit, resp, nil
+}
+
+// ResetGoodsAudit cancel the audit of goods.
+// Wechat API docs:
+// https://developers.weixin.qq.com/miniprogram/dev/platform-capabilities/industry/liveplayer/commodity-api.html
+func (s *WXAService) ResetGoodsAudit(ctx context.Context, token string, goodsID, auditID int) (*Response, error) {
+	u := fmt.Sprintf("wxaapi/broadcast/goods/resetaudit?access_token=%v", token)
+	payload := &GoodsAudit{GoodsID: goodsID, AuditID: auditID}
+	req, err := s.client.NewRequest(http.MethodPost, u, payload)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+// AuditGoods submit goods which are not audited to audit again.
+// Wechat API docs:
+// https://developers.weixin.qq.com/miniprogram/dev/platform-capabilities/industry/liveplayer/commodity-api.html
+func (s *WXAService) AuditGoods(ctx context.Context, token string, goodsID int) (*GoodsAudit, *Response, error) {
+	u := fmt.Sprintf("wxaapi/broadcast/goods/audit?access_token=%v", token)
+	payload := struct {
+		GoodsID int `json:"goodsId"`
+	}{GoodsID: goodsID}
+	req, err := s.client.NewRequest(http.MethodPost, u, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+	audit := new(GoodsAudit)
+	resp, err := s.client.Do(ctx, req, audit)
+	if err != nil {
+		return nil, resp, err
+	}
+	return audit, resp, nil
+}
+
+// DeleteGoods delete goods from the goods library.
+// Wechat API docs:
+// https://developers.weixin.qq.com/miniprogram/dev/platform-capabilities/industry/liveplayer/commodity-api.html
+func (s *WXAService) DeleteGoods(ctx context.Context, token string, goodsID int) (*Response, error) {
+	u := fmt.Sprintf("wxaapi/broadcast/goods/delete?access_token=%v", token)
+	payload := struct {
+		GoodsID int `json:"goodsId"`
+	}{GoodsID: goodsID}
+	req, err := s.client.NewRequest(http.MethodPost, u, payload)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+// UpdateGoods update goods, info.GoodsID is required.
+// Wechat API docs:
+// https://developers.weixin.qq.com/miniprogram/dev/platform-capabilities/industry/liveplayer/commodity-api.html
+func (s *WXAService) UpdateGoods(ctx context.Context, token string, info *GoodsInfo) (*Response, error) {
+	u := fmt.Sprintf("wxaapi/broadcast/goods/update?access_token=%v", token)
+	payload := struct {
+		GoodsInfo *GoodsInfo `json:"goodsInfo"`
+	}{GoodsInfo: info}
+	req, err := s.client.NewRequest(http.MethodPost, u, payload)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+// WarehouseGoods represents goods in the goods library.
+type WarehouseGoods struct {
+	GoodsID       int              `json:"goods_id"`
+	CoverImgURL   string           `json:"cover_img_url"`
+	Name          string           `json:"name"`
+	Price         float64          `json:"price"`
+	Price2        float64          `json:"price2"`
+	URL           string           `json:"url"`
+	PriceType     PriceType        `json:"price_type"`
+	AuditStatus   GoodsAuditStatus `json:"audit_status"`
+	ThirdPartyTag int              `json:"third_party_tag"`
+}
+
+// GoodsWarehouse represents get goods warehouse response.
+type GoodsWarehouse struct {
+	Goods []*WarehouseGoods `json:"goods"`
+	Total int               `json:"total"`
+}
+
+// GetGoodsWarehouse fetch goods and their audit status by ids.
+// Wechat API docs:
+// https://developers.weixin.qq.com/miniprogram/dev/platform-capabilities/industry/liveplayer/commodity-api.html
+func (s *WXAService) GetGoodsWarehouse(ctx context.Context, token string, goodsIDs []int) (*GoodsWarehouse, *Response, error) {
+	u := fmt.Sprintf("wxa/business/getgoodswarehouse?access_token=%v", token)
+	payload := struct {
+		GoodsIDs []int `json:"goods_ids"`
+	}{GoodsIDs: goodsIDs}
+	req, err := s.client.NewRequest(http.MethodPost, u, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+	warehouse := new(GoodsWarehouse)
+	resp, err := s.client.Do(ctx, req, warehouse)
+	if err != nil {
+		return nil, resp, err
+	}
+	return warehouse, resp, nil
+}
+
+// ApprovedGoods represents goods listed by GetApprovedGoods.
+type ApprovedGoods struct {
+	GoodsID         int       `json:"goodsId"`
+	CoverImgURL     string    `json:"coverImgUrl"`
+	Name            string    `json:"name"`
+	Price           float64   `json:"price"`
+	Price2          float64   `json:"price2"`
+	URL             string    `json:"url"`
+	PriceType       PriceType `json:"priceType"`
+	ThirdPartyTag   int       `json:"thirdPartyTag"`
+	ThirdPartyAppID string    `json:"thirdPartyAppid,omitempty"`
+}
+
+// ApprovedGoodsList represents get approved goods response.
+type ApprovedGoodsList struct {
+	Goods []*ApprovedGoods `json:"goods"`
+	Total int              `json:"total"`
+}
+
+// GetApprovedGoodsRequest represents request of get approved goods.
+type GetApprovedGoodsRequest struct {
+	Offset int
+	Limit  int
+	Status GoodsAuditStatus
+}
+
+// GetApprovedGoods fetch a page of goods of the given audit status.
+// Wechat API docs:
+// https://developers.weixin.qq.com/miniprogram/dev/platform-capabilities/industry/liveplayer/commodity-api.html
+func (s *WXAService) GetApprovedGoods(ctx context.Context, token string, r *GetApprovedGoodsRequest) (*ApprovedGoodsList, *Response, error) {
+	u := fmt.Sprintf("wxaapi/broadcast/goods/getapproved?access_token=%v&offset=%d&limit=%d&status=%d", token, r.Offset, r.Limit, r.Status)
+	req, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	list := new(ApprovedGoodsList)
+	resp, err := s.client.Do(ctx, req, list)
+	if err != nil {
+		return nil, resp, err
+	}
+	return list, resp, nil
+}
+
+// maxApprovedGoodsLimit is the maximum page size of GetApprovedGoods.
+const maxApprovedGoodsLimit = 100
+
+// ApprovedGoodsIterator iterates over all the goods of an audit status.
+type ApprovedGoodsIterator struct {
+	it *pageIterator
+}
+
+// Next advances to the next goods. It returns false when there are no more
+// goods, an error occurred or the context is done.
+func (it *ApprovedGoodsIterator) Next() bool { return it.it.next() }
+
+// Goods returns the current goods.
+func (it *ApprovedGoodsIterator) Goods() *ApprovedGoods { return it.it.cur.(*ApprovedGoods) }
+
+// Err returns the error which stopped the iteration, if any.
+func (it *ApprovedGoodsIterator) Err() error { return it.it.err }
+
+// AllApprovedGoods returns an iterator over all the goods of the given audit
+// status, fetching the maximum page size at a time.
+func (s *WXAService) AllApprovedGoods(ctx context.Context, token string, status GoodsAuditStatus) *ApprovedGoodsIterator {
+	offset := 0
+	return &ApprovedGoodsIterator{it: newPageIterator(ctx, func(ctx context.Context) ([]interface{}, bool, error) {
+		r := &GetApprovedGoodsRequest{Offset: offset, Limit: maxApprovedGoodsLimit, Status: status}
+		list, _, err := s.GetApprovedGoods(ctx, token, r)
+		if err != nil {
+			return nil, false, err
+		}
+		items := make([]interface{}, len(list.Goods))
+		for i, g := range list.Goods {
+			items[i] = g
+		}
+		offset += len(list.Goods)
+		return items, offset < list.Total, nil
+	})}
+}
+
+// ImportGoods add approved goods to a live room.
+// Wechat API docs:
+// https://developers.weixin.qq.com/miniprogram/dev/platform-capabilities/industry/liveplayer/studio-api.html
+func (s *WXAService) ImportGoods(ctx context.Context, token string, roomID int, goodsIDs []int) (*Response, error) {
+	u := fmt.Sprintf("wxaapi/broadcast/room/addgoods?access_token=%v", token)
+	payload := struct {
+		IDs    []int `json:"ids"`
+		RoomID int   `json:"roomId"`
+	}{IDs: goodsIDs, RoomID: roomID}
+	req, err := s.client.NewRequest(http.MethodPost, u, payload)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+// DeleteGoodsInRoom remove goods from a live room.
+// Wechat API docs:
+// https://developers.weixin.qq.com/miniprogram/dev/platform-capabilities/industry/liveplayer/commodity-api.html
+func (s *WXAService) DeleteGoodsInRoom(ctx context.Context, token string, roomID, goodsID int) (*Response, error) {
+	u := fmt.Sprintf("wxaapi/broadcast/goods/deleteInRoom?access_token=%v", token)
+	payload := struct {
+		RoomID  int `json:"roomId"`
+		GoodsID int `json:"goodsId"`
+	}{RoomID: roomID, GoodsID: goodsID}
+	req, err := s.client.NewRequest(http.MethodPost, u, payload)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
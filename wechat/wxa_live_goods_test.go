@@ -0,0 +1,226 @@
+package wechat
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestWXAService_AddGoods(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/wxaapi/broadcast/goods/add", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"goodsInfo":{"coverImgUrl":"media_id","name":"TIT茶杯","priceType":2,"price":99.5,"price2":150.5,"url":"pages/index/index"}}`+"\n")
+		fmt.Fprint(w, `{"errcode": 0, "goodsId": 51, "auditId": 525022184}`)
+	})
+	got, _, err := client.WXA.AddGoods(context.Background(), "token", &GoodsInfo{
+		CoverImgURL: "media_id",
+		Name:        "TIT茶杯",
+		PriceType:   PriceTypeRange,
+		Price:       99.5,
+		Price2:      150.5,
+		URL:         "pages/index/index",
+	})
+	if err != nil {
+		t.Errorf("WXA.AddGoods retured err: %v", err)
+	}
+	want := &GoodsAudit{GoodsID: 51, AuditID: 525022184}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("WXA.AddGoods got %+v, want %+v", got, want)
+	}
+}
+
+func TestWXAService_AuditGoods(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/wxaapi/broadcast/goods/audit", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"goodsId":51}`+"\n")
+		fmt.Fprint(w, `{"errcode": 0, "auditId": 525022184}`)
+	})
+	got, _, err := client.WXA.AuditGoods(context.Background(), "token", 51)
+	if err != nil {
+		t.Errorf("WXA.AuditGoods retured err: %v", err)
+	}
+	want := &GoodsAudit{AuditID: 525022184}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("WXA.AuditGoods got %+v, want %+v", got, want)
+	}
+}
+
+func TestWXAService_LiveGoodsUpdates(t *testing.T) {
+	tests := []struct {
+		path string
+		body string
+		call func(s *WXAService) (*Response, error)
+	}{
+		{"goods/resetaudit", `{"goodsId":51,"auditId":525022184}`, func(s *WXAService) (*Response, error) {
+			return s.ResetGoodsAudit(context.Background(), "token", 51, 525022184)
+		}},
+		{"goods/delete", `{"goodsId":51}`, func(s *WXAService) (*Response, error) {
+			return s.DeleteGoods(context.Background(), "token", 51)
+		}},
+		{"goods/update", `{"goodsInfo":{"goodsId":51,"priceType":1,"price":80}}`, func(s *WXAService) (*Response, error) {
+			return s.UpdateGoods(context.Background(), "token", &GoodsInfo{GoodsID: 51, PriceType: PriceTypeFixed, Price: 80})
+		}},
+		{"room/addgoods", `{"ids":[9,11],"roomId":33}`, func(s *WXAService) (*Response, error) {
+			return s.ImportGoods(context.Background(), "token", 33, []int{9, 11})
+		}},
+		{"goods/deleteInRoom", `{"roomId":33,"goodsId":51}`, func(s *WXAService) (*Response, error) {
+			return s.DeleteGoodsInRoom(context.Background(), "token", 33, 51)
+		}},
+	}
+	for _, tt := range tests {
+		client, mux, _, tearDown := setup()
+		mux.HandleFunc("/wxaapi/broadcast/"+tt.path, func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodPost)
+			testBody(t, r, tt.body+"\n")
+			fmt.Fprint(w, `{"errcode": 0}`)
+		})
+		if _, err := tt.call(client.WXA); err != nil {
+			t.Errorf("WXA %s retured err: %v", tt.path, err)
+		}
+		tearDown()
+	}
+}
+
+func TestWXAService_GetGoodsWarehouse(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/wxa/business/getgoodswarehouse", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"goods_ids":[51]}`+"\n")
+		fmt.Fprint(w, `{
+							  "errcode": 0,
+							  "errmsg": "ok",
+							  "goods": [
+								{
+								  "goods_id": 51,
+								  "cover_img_url": "http://mmbiz.qpic.cn/xxx",
+								  "name": "商品",
+								  "price": 1,
+								  "url": "pages/index/index",
+								  "price_type": 1,
+								  "price2": 0,
+								  "audit_status": 2,
+								  "third_party_tag": 2
+								}
+							  ],
+							  "total": 1
+							}`)
+	})
+	got, _, err := client.WXA.GetGoodsWarehouse(context.Background(), "token", []int{51})
+	if err != nil {
+		t.Errorf("WXA.GetGoodsWarehouse retured err: %v", err)
+	}
+	want := &GoodsWarehouse{
+		Goods: []*WarehouseGoods{{
+			GoodsID:       51,
+			CoverImgURL:   "http://mmbiz.qpic.cn/xxx",
+			Name:          "商品",
+			Price:         1,
+			URL:           "pages/index/index",
+			PriceType:     PriceTypeFixed,
+			AuditStatus:   GoodsAuditStatusApproved,
+			ThirdPartyTag: 2,
+		}},
+		Total: 1,
+	}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("WXA.GetGoodsWarehouse got %+v, want %+v", got, want)
+	}
+}
+
+func TestWXAService_GetApprovedGoods(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/wxaapi/broadcast/goods/getapproved", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		q := r.URL.Query()
+		if q.Get("offset") != "10" || q.Get("limit") != "5" || q.Get("status") != "2" {
+			t.Errorf("Request query = %v", q)
+		}
+		fmt.Fprint(w, `{
+							  "errcode": 0,
+							  "total": 11,
+							  "goods": [
+								{
+								  "goodsId": 9,
+								  "coverImgUrl": "http://mmbiz.qpic.cn/xxx",
+								  "name": "商品",
+								  "price": 12,
+								  "price2": 0,
+								  "url": "pages/index/index",
+								  "priceType": 1,
+								  "thirdPartyTag": 0
+								}
+							  ]
+							}`)
+	})
+	got, _, err := client.WXA.GetApprovedGoods(context.Background(), "token", &GetApprovedGoodsRequest{
+		Offset: 10,
+		Limit:  5,
+		Status: GoodsAuditStatusApproved,
+	})
+	if err != nil {
+		t.Errorf("WXA.GetApprovedGoods retured err: %v", err)
+	}
+	want := &ApprovedGoodsList{
+		Goods: []*ApprovedGoods{{
+			GoodsID:     9,
+			CoverImgURL: "http://mmbiz.qpic.cn/xxx",
+			Name:        "商品",
+			Price:       12,
+			URL:         "pages/index/index",
+			PriceType:   PriceTypeFixed,
+		}},
+		Total: 11,
+	}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("WXA.GetApprovedGoods got %+v, want %+v", got, want)
+	}
+}
+
+func TestWXAService_AllApprovedGoods(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	const total = 150
+	mux.HandleFunc("/wxaapi/broadcast/goods/getapproved", func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		if limit != 100 {
+			t.Errorf("Request limit = %d, want 100", limit)
+		}
+		list := `{"errcode": 0, "total": 150, "goods": [`
+		for i := offset; i < offset+limit && i < total; i++ {
+			if i > offset {
+				list += ","
+			}
+			list += fmt.Sprintf(`{"goodsId": %d}`, i)
+		}
+		fmt.Fprint(w, list+"]}")
+	})
+	it := client.WXA.AllApprovedGoods(context.Background(), "token", GoodsAuditStatusApproved)
+	n := 0
+	for it.Next() {
+		if got := it.Goods().GoodsID; got != n {
+			t.Errorf("ApprovedGoodsIterator got goods %d, want %d", got, n)
+		}
+		n++
+	}
+	if err := it.Err(); err != nil {
+		t.Errorf("ApprovedGoodsIterator.Err() = %v", err)
+	}
+	if n != total {
+		t.Errorf("ApprovedGoodsIterator returned %d goods, want %d", n, total)
+	}
+}
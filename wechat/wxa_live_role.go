@@ -0,0 +1,237 @@
+package wechat
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// LiveRole represents the role of a live member.
+type LiveRole int
+
+// Live roles.
+const (
+	LiveRoleAll        LiveRole = -1 // 所有成员, only for GetRoleList
+	LiveRoleSuperAdmin LiveRole = 0  // 超级管理员
+	LiveRoleAdmin      LiveRole = 1  // 管理员
+	LiveRoleAnchor     LiveRole = 2  // 主播
+	LiveRoleOperator   LiveRole = 3  // 运营者
+)
+
+// AddRole grant a role to a WeChat user.
+// Wechat API docs:
+// https://developers.weixin.qq.com/miniprogram/dev/platform-capabilities/industry/liveplayer/role-manage.html
+func (s *WXAService) AddRole(ctx context.Context, token, username string, role LiveRole) (*Response, error) {
+	u := fmt.Sprintf("wxaapi/broadcast/role/addrole?access_token=%v", token)
+	payload := struct {
+		Username string   `json:"username"`
+		Role     LiveRole `json:"role"`
+	}{Username: username, Role: role}
+	req, err := s.client.NewRequest(http.MethodPost, u, payload)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+// DeleteRole revoke a role of a WeChat user.
+// Wechat API docs:
+// https://developers.weixin.qq.com/miniprogram/dev/platform-capabilities/industry/liveplayer/role-manage.html
+func (s *WXAService) DeleteRole(ctx context.Context, token, username string, role LiveRole) (*Response, error) {
+	u := fmt.Sprintf("wxaapi/broadcast/role/deleterole?access_token=%v", token)
+	payload := struct {
+		Username string   `json:"username"`
+		Role     LiveRole `json:"role"`
+	}{Username: username, Role: role}
+	req, err := s.client.NewRequest(http.MethodPost, u, payload)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+// GetRoleListRequest represents request of get role list. A nil Role lists
+// the members of all roles.
+type GetRoleListRequest struct {
+	Role    *LiveRole
+	Offset  int
+	Limit   int
+	Keyword string
+}
+
+// LiveMember represents a live member and its roles.
+type LiveMember struct {
+	HeadingImg      string     `json:"headingimg"`
+	Nickname        string     `json:"nickname"`
+	OpenID          string     `json:"openid"`
+	RoleList        []LiveRole `json:"roleList"`
+	UpdateTimestamp string     `json:"updateTimestamp"`
+	Username        string     `json:"username"`
+}
+
+// LiveMembers represents get role list response.
+type LiveMembers struct {
+	Total int           `json:"total"`
+	List  []*LiveMember `json:"list"`
+}
+
+// GetRoleList fetch a page of live members of the given role, optionally
+// searched by keyword.
+// Wechat API docs:
+// https://developers.weixin.qq.com/miniprogram/dev/platform-capabilities/industry/liveplayer/role-manage.html
+func (s *WXAService) GetRoleList(ctx context.Context, token string, r *GetRoleListRequest) (*LiveMembers, *Response, error) {
+	u := fmt.Sprintf("wxaapi/broadcast/role/getrolelist?access_token=%v&offset=%d&limit=%d", token, r.Offset, r.Limit)
+	if r.Role != nil {
+		u += fmt.Sprintf("&role=%d", *r.Role)
+	}
+	if r.Keyword != "" {
+		u += "&keyword=" + url.QueryEscape(r.Keyword)
+	}
+	req, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	members := new(LiveMembers)
+	resp, err := s.client.Do(ctx, req, members)
+	if err != nil {
+		return nil, resp, err
+	}
+	return members, resp, nil
+}
+
+// maxRoleListLimit is the maximum page size of GetRoleList.
+const maxRoleListLimit = 30
+
+// LiveMemberIterator iterates over all the live members of a role.
+type LiveMemberIterator struct {
+	it *pageIterator
+}
+
+// Next advances to the next member. It returns false when there are no more
+// members, an error occurred or the context is done.
+func (it *LiveMemberIterator) Next() bool { return it.it.next() }
+
+// Member returns the current member.
+func (it *LiveMemberIterator) Member() *LiveMember { return it.it.cur.(*LiveMember) }
+
+// Err returns the error which stopped the iteration, if any.
+func (it *LiveMemberIterator) Err() error { return it.it.err }
+
+// AllRoles returns an iterator over all the live members of the given role,
+// optionally searched by keyword.
+func (s *WXAService) AllRoles(ctx context.Context, token string, role LiveRole, keyword string) *LiveMemberIterator {
+	offset := 0
+	return &LiveMemberIterator{it: newPageIterator(ctx, func(ctx context.Context) ([]interface{}, bool, error) {
+		r := &GetRoleListRequest{Role: &role, Offset: offset, Limit: maxRoleListLimit, Keyword: keyword}
+		members, _, err := s.GetRoleList(ctx, token, r)
+		if err != nil {
+			return nil, false, err
+		}
+		items := make([]interface{}, len(members.List))
+		for i, m := range members.List {
+			items[i] = m
+		}
+		offset += len(members.List)
+		return items, offset < members.Total, nil
+	})}
+}
+
+// LiveMessage represents push live message response.
+type LiveMessage struct {
+	MessageID string `json:"message_id"`
+}
+
+// PushLiveMessage notify the followers that a live room starts.
+// Wechat API docs:
+// https://developers.weixin.qq.com/miniprogram/dev/platform-capabilities/industry/liveplayer/subscribe-api.html
+func (s *WXAService) PushLiveMessage(ctx context.Context, token string, roomID int, openIDs []string) (*LiveMessage, *Response, error) {
+	u := fmt.Sprintf("wxa/business/push_message?access_token=%v", token)
+	payload := struct {
+		RoomID     int      `json:"room_id"`
+		UserOpenID []string `json:"user_openid"`
+	}{RoomID: roomID, UserOpenID: openIDs}
+	req, err := s.client.NewRequest(http.MethodPost, u, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+	message := new(LiveMessage)
+	resp, err := s.client.Do(ctx, req, message)
+	if err != nil {
+		return nil, resp, err
+	}
+	return message, resp, nil
+}
+
+// LiveFollower represents a follower of the live rooms.
+type LiveFollower struct {
+	RoomID        int        `json:"room_id"`
+	OpenID        string     `json:"openid"`
+	ListID        int64      `json:"list_id,omitempty"`
+	RoomStatus    LiveStatus `json:"room_status,omitempty"`
+	RoomStartTime int64      `json:"room_start_time,omitempty"`
+}
+
+// LiveFollowers represents get followers response.
+type LiveFollowers struct {
+	Followers []*LiveFollower `json:"followers"`
+	PageBreak int64           `json:"page_break"`
+}
+
+// GetFollowers fetch a page of followers, pageBreak is the PageBreak of the
+// previous page, or 0 for the first page.
+// Wechat API docs:
+// https://developers.weixin.qq.com/miniprogram/dev/platform-capabilities/industry/liveplayer/subscribe-api.html
+func (s *WXAService) GetFollowers(ctx context.Context, token string, limit int, pageBreak int64) (*LiveFollowers, *Response, error) {
+	u := fmt.Sprintf("wxa/business/get_followers?access_token=%v", token)
+	payload := struct {
+		Limit     int   `json:"limit"`
+		PageBreak int64 `json:"page_break,omitempty"`
+	}{Limit: limit, PageBreak: pageBreak}
+	req, err := s.client.NewRequest(http.MethodPost, u, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+	followers := new(LiveFollowers)
+	resp, err := s.client.Do(ctx, req, followers)
+	if err != nil {
+		return nil, resp, err
+	}
+	return followers, resp, nil
+}
+
+// maxFollowersLimit is the page size used to walk the followers.
+const maxFollowersLimit = 100
+
+// LiveFollowerIterator iterates over all the followers.
+type LiveFollowerIterator struct {
+	it *pageIterator
+}
+
+// Next advances to the next follower. It returns false when there are no
+// more followers, an error occurred or the context is done.
+func (it *LiveFollowerIterator) Next() bool { return it.it.next() }
+
+// Follower returns the current follower.
+func (it *LiveFollowerIterator) Follower() *LiveFollower { return it.it.cur.(*LiveFollower) }
+
+// Err returns the error which stopped the iteration, if any.
+func (it *LiveFollowerIterator) Err() error { return it.it.err }
+
+// AllFollowers returns an iterator over all the followers.
+func (s *WXAService) AllFollowers(ctx context.Context, token string) *LiveFollowerIterator {
+	var pageBreak int64
+	return &LiveFollowerIterator{it: newPageIterator(ctx, func(ctx context.Context) ([]interface{}, bool, error) {
+		followers, _, err := s.GetFollowers(ctx, token, maxFollowersLimit, pageBreak)
+		if err != nil {
+			return nil, false, err
+		}
+		items := make([]interface{}, len(followers.Followers))
+		for i, f := range followers.Followers {
+			items[i] = f
+		}
+		more := followers.PageBreak != 0 && followers.PageBreak != pageBreak
+		pageBreak = followers.PageBreak
+		return items, more, nil
+	})}
+}
@@ -0,0 +1,220 @@
+package wechat
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestWXAService_AddRole(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/wxaapi/broadcast/role/addrole", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"username":"wx","role":2}`+"\n")
+		fmt.Fprint(w, `{"errcode": 0}`)
+	})
+	_, err := client.WXA.AddRole(context.Background(), "token", "wx", LiveRoleAnchor)
+	if err != nil {
+		t.Errorf("WXA.AddRole retured err: %v", err)
+	}
+}
+
+func TestWXAService_DeleteRole(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/wxaapi/broadcast/role/deleterole", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"username":"wx","role":1}`+"\n")
+		fmt.Fprint(w, `{"errcode": 0}`)
+	})
+	_, err := client.WXA.DeleteRole(context.Background(), "token", "wx", LiveRoleAdmin)
+	if err != nil {
+		t.Errorf("WXA.DeleteRole retured err: %v", err)
+	}
+}
+
+func TestWXAService_GetRoleList(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/wxaapi/broadcast/role/getrolelist", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		q := r.URL.Query()
+		if q.Get("role") != "-1" || q.Get("offset") != "0" || q.Get("limit") != "10" || q.Get("keyword") != "小 明" {
+			t.Errorf("Request query = %v", q)
+		}
+		fmt.Fprint(w, `{
+							  "errcode": 0,
+							  "total": 1,
+							  "list": [
+								{
+								  "headingimg": "http://wx.qlogo.cn/xxx",
+								  "nickname": "小 明",
+								  "openid": "openid",
+								  "roleList": [1, 2],
+								  "updateTimestamp": "1621325100",
+								  "username": "wx"
+								}
+							  ]
+							}`)
+	})
+	role := LiveRoleAll
+	got, _, err := client.WXA.GetRoleList(context.Background(), "token", &GetRoleListRequest{
+		Role:    &role,
+		Limit:   10,
+		Keyword: "小 明",
+	})
+	if err != nil {
+		t.Errorf("WXA.GetRoleList retured err: %v", err)
+	}
+	want := &LiveMembers{
+		Total: 1,
+		List: []*LiveMember{{
+			HeadingImg:      "http://wx.qlogo.cn/xxx",
+			Nickname:        "小 明",
+			OpenID:          "openid",
+			RoleList:        []LiveRole{LiveRoleAdmin, LiveRoleAnchor},
+			UpdateTimestamp: "1621325100",
+			Username:        "wx",
+		}},
+	}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("WXA.GetRoleList got %+v, want %+v", got, want)
+	}
+}
+
+func TestWXAService_GetRoleList_allRoles(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/wxaapi/broadcast/role/getrolelist", func(w http.ResponseWriter, r *http.Request) {
+		if q := r.URL.Query(); q["role"] != nil {
+			t.Errorf("Request query = %v, want no role", q)
+		}
+		fmt.Fprint(w, `{"errcode": 0, "total": 0, "list": []}`)
+	})
+	_, _, err := client.WXA.GetRoleList(context.Background(), "token", &GetRoleListRequest{Limit: 10})
+	if err != nil {
+		t.Errorf("WXA.GetRoleList retured err: %v", err)
+	}
+}
+
+func TestWXAService_AllRoles(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	const total = 45
+	mux.HandleFunc("/wxaapi/broadcast/role/getrolelist", func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		if limit != 30 {
+			t.Errorf("Request limit = %d, want 30", limit)
+		}
+		list := `{"errcode": 0, "total": 45, "list": [`
+		for i := offset; i < offset+limit && i < total; i++ {
+			if i > offset {
+				list += ","
+			}
+			list += fmt.Sprintf(`{"username": "%d"}`, i)
+		}
+		fmt.Fprint(w, list+"]}")
+	})
+	it := client.WXA.AllRoles(context.Background(), "token", LiveRoleAnchor, "")
+	n := 0
+	for it.Next() {
+		if got, want := it.Member().Username, strconv.Itoa(n); got != want {
+			t.Errorf("LiveMemberIterator got member %s, want %s", got, want)
+		}
+		n++
+	}
+	if err := it.Err(); err != nil {
+		t.Errorf("LiveMemberIterator.Err() = %v", err)
+	}
+	if n != total {
+		t.Errorf("LiveMemberIterator returned %d members, want %d", n, total)
+	}
+}
+
+func TestWXAService_PushLiveMessage(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/wxa/business/push_message", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"room_id":33,"user_openid":["a","b"]}`+"\n")
+		fmt.Fprint(w, `{"errcode": 0, "message_id": "123"}`)
+	})
+	got, _, err := client.WXA.PushLiveMessage(context.Background(), "token", 33, []string{"a", "b"})
+	if err != nil {
+		t.Errorf("WXA.PushLiveMessage retured err: %v", err)
+	}
+	want := &LiveMessage{MessageID: "123"}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("WXA.PushLiveMessage got %+v, want %+v", got, want)
+	}
+}
+
+func TestWXAService_GetFollowers(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/wxa/business/get_followers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"limit":10,"page_break":7}`+"\n")
+		fmt.Fprint(w, `{
+							  "errcode": 0,
+							  "followers": [
+								{"room_id": 33, "openid": "openid", "list_id": 1, "room_status": 102, "room_start_time": 1588237130}
+							  ],
+							  "page_break": 8
+							}`)
+	})
+	got, _, err := client.WXA.GetFollowers(context.Background(), "token", 10, 7)
+	if err != nil {
+		t.Errorf("WXA.GetFollowers retured err: %v", err)
+	}
+	want := &LiveFollowers{
+		Followers: []*LiveFollower{
+			{RoomID: 33, OpenID: "openid", ListID: 1, RoomStatus: 102, RoomStartTime: 1588237130},
+		},
+		PageBreak: 8,
+	}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("WXA.GetFollowers got %+v, want %+v", got, want)
+	}
+}
+
+func TestWXAService_AllFollowers(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	pages := map[int64]string{
+		0: `{"errcode": 0, "followers": [{"openid": "a"}, {"openid": "b"}], "page_break": 2}`,
+		2: `{"errcode": 0, "followers": [{"openid": "c"}], "page_break": 0}`,
+	}
+	mux.HandleFunc("/wxa/business/get_followers", func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			PageBreak int64 `json:"page_break"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		fmt.Fprint(w, pages[body.PageBreak])
+	})
+	it := client.WXA.AllFollowers(context.Background(), "token")
+	var got []string
+	for it.Next() {
+		got = append(got, it.Follower().OpenID)
+	}
+	if err := it.Err(); err != nil {
+		t.Errorf("LiveFollowerIterator.Err() = %v", err)
+	}
+	if want := []string{"a", "b", "c"}; !reflect.DeepEqual(want, got) {
+		t.Errorf("LiveFollowerIterator got %v, want %v", got, want)
+	}
+}
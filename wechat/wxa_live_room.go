@@ -0,0 +1,356 @@
+package wechat
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// CreateRoomRequest represents request of create live room.
+type CreateRoomRequest struct {
+	Name            string `json:"name"`
+	CoverImg        string `json:"coverImg"`
+	StartTime       int64  `json:"startTime"`
+	EndTime         int64  `json:"endTime"`
+	AnchorName      string `json:"anchorName"`
+	AnchorWechat    string `json:"anchorWechat"`
+	SubAnchorWechat string `json:"subAnchorWechat,omitempty"`
+	CreaterWechat   string `json:"createrWechat,omitempty"`
+	ShareImg        string `json:"shareImg"`
+	FeedsImg        string `json:"feedsImg,omitempty"`
+	IsFeedsPublic   int    `json:"isFeedsPublic,omitempty"`
+	Type            int    `json:"type"`
+	CloseLike       int    `json:"closeLike"`
+	CloseGoods      int    `json:"closeGoods"`
+	CloseComment    int    `json:"closeComment"`
+	CloseReplay     int    `json:"closeReplay,omitempty"`
+	CloseShare      int    `json:"closeShare,omitempty"`
+	CloseKf         int    `json:"closeKf,omitempty"`
+}
+
+// CreatedRoom represents create live room response.
+type CreatedRoom struct {
+	RoomID    int    `json:"roomId"`
+	QRCodeURL string `json:"qrcode_url,omitempty"`
+}
+
+// CreateRoom create a live room.
+// Wechat API docs:
+// https://developers.weixin.qq.com/miniprogram/dev/platform-capabilities/industry/liveplayer/studio-api.html
+func (s *WXAService) CreateRoom(ctx context.Context, token string, r *CreateRoomRequest) (*CreatedRoom, *Response, error) {
+	u := fmt.Sprintf("wxaapi/broadcast/room/create?access_token=%v", token)
+	req, err := s.client.NewRequest(http.MethodPost, u, r)
+	if err != nil {
+		return nil, nil, err
+	}
+	room := new(CreatedRoom)
+	resp, err := s.client.Do(ctx, req, room)
+	if err != nil {
+		return nil, resp, err
+	}
+	return room, resp, nil
+}
+
+// DeleteRoom delete a live room.
+// Wechat API docs:
+// https://developers.weixin.qq.com/miniprogram/dev/platform-capabilities/industry/liveplayer/studio-api.html
+func (s *WXAService) DeleteRoom(ctx context.Context, token string, roomID int) (*Response, error) {
+	u := fmt.Sprintf("wxaapi/broadcast/room/deleteroom?access_token=%v", token)
+	payload := struct {
+		ID int `json:"id"`
+	}{ID: roomID}
+	req, err := s.client.NewRequest(http.MethodPost, u, payload)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+// EditRoomRequest represents request of edit live room.
+type EditRoomRequest struct {
+	ID int `json:"id"`
+	CreateRoomRequest
+}
+
+// EditRoom edit a live room.
+// Wechat API docs:
+// https://developers.weixin.qq.com/miniprogram/dev/platform-capabilities/industry/liveplayer/studio-api.html
+func (s *WXAService) EditRoom(ctx context.Context, token string, r *EditRoomRequest) (*Response, error) {
+	u := fmt.Sprintf("wxaapi/broadcast/room/editroom?access_token=%v", token)
+	req, err := s.client.NewRequest(http.MethodPost, u, r)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+// PushURL represents the push address of a live room.
+type PushURL struct {
+	PushAddr string `json:"pushAddr"`
+}
+
+// GetPushURL fetch the push address of a live room.
+// Wechat API docs:
+// https://developers.weixin.qq.com/miniprogram/dev/platform-capabilities/industry/liveplayer/studio-api.html
+func (s *WXAService) GetPushURL(ctx context.Context, token string, roomID int) (*PushURL, *Response, error) {
+	u := fmt.Sprintf("wxaapi/broadcast/room/getpushurl?access_token=%v&roomId=%d", token, roomID)
+	req, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	pushURL := new(PushURL)
+	resp, err := s.client.Do(ctx, req, pushURL)
+	if err != nil {
+		return nil, resp, err
+	}
+	return pushURL, resp, nil
+}
+
+// SharedCode represents the share code of a live room.
+type SharedCode struct {
+	CDNURL    string `json:"cdnUrl"`
+	PagePath  string `json:"pagePath"`
+	PosterURL string `json:"posterUrl"`
+}
+
+// GetSharedCode fetch the share code of a live room, params are the custom
+// parameters passed to the live room page.
+// Wechat API docs:
+// https://developers.weixin.qq.com/miniprogram/dev/platform-capabilities/industry/liveplayer/studio-api.html
+func (s *WXAService) GetSharedCode(ctx context.Context, token string, roomID int, params string) (*SharedCode, *Response, error) {
+	u := fmt.Sprintf("wxaapi/broadcast/room/getsharedcode?access_token=%v&roomId=%d", token, roomID)
+	if params != "" {
+		u += "&params=" + url.QueryEscape(params)
+	}
+	req, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	code := new(SharedCode)
+	resp, err := s.client.Do(ctx, req, code)
+	if err != nil {
+		return nil, resp, err
+	}
+	return code, resp, nil
+}
+
+// RoomUser represents a user of a live room, such as an assistant.
+type RoomUser struct {
+	Username string `json:"username"`
+	Nickname string `json:"nickname,omitempty"`
+}
+
+// AddAssistant add assistants to a live room.
+// Wechat API docs:
+// https://developers.weixin.qq.com/miniprogram/dev/platform-capabilities/industry/liveplayer/studio-api.html
+func (s *WXAService) AddAssistant(ctx context.Context, token string, roomID int, users []*RoomUser) (*Response, error) {
+	u := fmt.Sprintf("wxaapi/broadcast/room/addassistant?access_token=%v", token)
+	payload := struct {
+		RoomID int         `json:"roomId"`
+		Users  []*RoomUser `json:"users"`
+	}{RoomID: roomID, Users: users}
+	req, err := s.client.NewRequest(http.MethodPost, u, payload)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+// ModifyAssistant modify the nickname of an assistant of a live room.
+// Wechat API docs:
+// https://developers.weixin.qq.com/miniprogram/dev/platform-capabilities/industry/liveplayer/studio-api.html
+func (s *WXAService) ModifyAssistant(ctx context.Context, token string, roomID int, user *RoomUser) (*Response, error) {
+	u := fmt.Sprintf("wxaapi/broadcast/room/modifyassistant?access_token=%v", token)
+	payload := struct {
+		RoomID int `json:"roomId"`
+		*RoomUser
+	}{RoomID: roomID, RoomUser: user}
+	req, err := s.client.NewRequest(http.MethodPost, u, payload)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+// RemoveAssistant remove an assistant from a live room.
+// Wechat API docs:
+// https://developers.weixin.qq.com/miniprogram/dev/platform-capabilities/industry/liveplayer/studio-api.html
+func (s *WXAService) RemoveAssistant(ctx context.Context, token string, roomID int, username string) (*Response, error) {
+	u := fmt.Sprintf("wxaapi/broadcast/room/removeassistant?access_token=%v", token)
+	payload := struct {
+		RoomID   int    `json:"roomId"`
+		Username string `json:"username"`
+	}{RoomID: roomID, Username: username}
+	req, err := s.client.NewRequest(http.MethodPost, u, payload)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+// Assistant represents an assistant of a live room.
+type Assistant struct {
+	Timestamp int64  `json:"timestamp"`
+	HeadImg   string `json:"headimg"`
+	Nickname  string `json:"nickname"`
+	Alias     string `json:"alias"`
+	OpenID    string `json:"openid"`
+}
+
+// Assistants represents an assistant list.
+type Assistants struct {
+	List     []*Assistant `json:"list"`
+	Count    int          `json:"count"`
+	MaxCount int          `json:"maxCount"`
+}
+
+// GetAssistantList fetch the assistants of a live room.
+// Wechat API docs:
+// https://developers.weixin.qq.com/miniprogram/dev/platform-capabilities/industry/liveplayer/studio-api.html
+func (s *WXAService) GetAssistantList(ctx context.Context, token string, roomID int) (*Assistants, *Response, error) {
+	u := fmt.Sprintf("wxaapi/broadcast/room/getassistantlist?access_token=%v&roomId=%d", token, roomID)
+	req, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	assistants := new(Assistants)
+	resp, err := s.client.Do(ctx, req, assistants)
+	if err != nil {
+		return nil, resp, err
+	}
+	return assistants, resp, nil
+}
+
+// AddSubAnchor add a sub anchor to a live room.
+// Wechat API docs:
+// https://developers.weixin.qq.com/miniprogram/dev/platform-capabilities/industry/liveplayer/studio-api.html
+func (s *WXAService) AddSubAnchor(ctx context.Context, token string, roomID int, username string) (*Response, error) {
+	u := fmt.Sprintf("wxaapi/broadcast/room/addsubanchor?access_token=%v", token)
+	payload := struct {
+		RoomID   int    `json:"roomId"`
+		Username string `json:"username"`
+	}{RoomID: roomID, Username: username}
+	req, err := s.client.NewRequest(http.MethodPost, u, payload)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+// ModifySubAnchor change the sub anchor of a live room.
+// Wechat API docs:
+// https://developers.weixin.qq.com/miniprogram/dev/platform-capabilities/industry/liveplayer/studio-api.html
+func (s *WXAService) ModifySubAnchor(ctx context.Context, token string, roomID int, username string) (*Response, error) {
+	u := fmt.Sprintf("wxaapi/broadcast/room/modifysubanchor?access_token=%v", token)
+	payload := struct {
+		RoomID   int    `json:"roomId"`
+		Username string `json:"username"`
+	}{RoomID: roomID, Username: username}
+	req, err := s.client.NewRequest(http.MethodPost, u, payload)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+// DeleteSubAnchor delete the sub anchor of a live room.
+// Wechat API docs:
+// https://developers.weixin.qq.com/miniprogram/dev/platform-capabilities/industry/liveplayer/studio-api.html
+func (s *WXAService) DeleteSubAnchor(ctx context.Context, token string, roomID int) (*Response, error) {
+	u := fmt.Sprintf("wxaapi/broadcast/room/deletesubanchor?access_token=%v", token)
+	payload := struct {
+		RoomID int `json:"roomId"`
+	}{RoomID: roomID}
+	req, err := s.client.NewRequest(http.MethodPost, u, payload)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+// SubAnchor represents the sub anchor of a live room.
+type SubAnchor struct {
+	Username string `json:"username"`
+}
+
+// GetSubAnchor fetch the sub anchor of a live room.
+// Wechat API docs:
+// https://developers.weixin.qq.com/miniprogram/dev/platform-capabilities/industry/liveplayer/studio-api.html
+func (s *WXAService) GetSubAnchor(ctx context.Context, token string, roomID int) (*SubAnchor, *Response, error) {
+	u := fmt.Sprintf("wxaapi/broadcast/room/getsubanchor?access_token=%v&roomId=%d", token, roomID)
+	req, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	anchor := new(SubAnchor)
+	resp, err := s.client.Do(ctx, req, anchor)
+	if err != nil {
+		return nil, resp, err
+	}
+	return anchor, resp, nil
+}
+
+// UpdateFeedPublic set whether a live room is shown in the official feeds.
+// Wechat API docs:
+// https://developers.weixin.qq.com/miniprogram/dev/platform-capabilities/industry/liveplayer/studio-api.html
+func (s *WXAService) UpdateFeedPublic(ctx context.Context, token string, roomID, isFeedsPublic int) (*Response, error) {
+	u := fmt.Sprintf("wxaapi/broadcast/room/updatefeedpublic?access_token=%v", token)
+	payload := struct {
+		RoomID        int `json:"roomId"`
+		IsFeedsPublic int `json:"isFeedsPublic"`
+	}{RoomID: roomID, IsFeedsPublic: isFeedsPublic}
+	req, err := s.client.NewRequest(http.MethodPost, u, payload)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+// UpdateReplay turn the replay of a live room on or off.
+// Wechat API docs:
+// https://developers.weixin.qq.com/miniprogram/dev/platform-capabilities/industry/liveplayer/studio-api.html
+func (s *WXAService) UpdateReplay(ctx context.Context, token string, roomID, closeReplay int) (*Response, error) {
+	u := fmt.Sprintf("wxaapi/broadcast/room/updatereplay?access_token=%v", token)
+	payload := struct {
+		RoomID      int `json:"roomId"`
+		CloseReplay int `json:"closeReplay"`
+	}{RoomID: roomID, CloseReplay: closeReplay}
+	req, err := s.client.NewRequest(http.MethodPost, u, payload)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+// UpdateKf turn the customer service of a live room on or off.
+// Wechat API docs:
+// https://developers.weixin.qq.com/miniprogram/dev/platform-capabilities/industry/liveplayer/studio-api.html
+func (s *WXAService) UpdateKf(ctx context.Context, token string, roomID, closeKf int) (*Response, error) {
+	u := fmt.Sprintf("wxaapi/broadcast/room/updatekf?access_token=%v", token)
+	payload := struct {
+		RoomID  int `json:"roomId"`
+		CloseKf int `json:"closeKf"`
+	}{RoomID: roomID, CloseKf: closeKf}
+	req, err := s.client.NewRequest(http.MethodPost, u, payload)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+// UpdateComment ban or allow the comments of a live room.
+// Wechat API docs:
+// https://developers.weixin.qq.com/miniprogram/dev/platform-capabilities/industry/liveplayer/studio-api.html
+func (s *WXAService) UpdateComment(ctx context.Context, token string, roomID, banComment int) (*Response, error) {
+	u := fmt.Sprintf("wxaapi/broadcast/room/updatecomment?access_token=%v", token)
+	payload := struct {
+		RoomID     int `json:"roomId"`
+		BanComment int `json:"banComment"`
+	}{RoomID: roomID, BanComment: banComment}
+	req, err := s.client.NewRequest(http.MethodPost, u, payload)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
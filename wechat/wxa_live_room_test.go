@@ -0,0 +1,222 @@
+package wechat
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestWXAService_CreateRoom(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/wxaapi/broadcast/room/create", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"name":"测试直播间","coverImg":"media_id","startTime":1588237130,"endTime":1588237240,"anchorName":"zefzhang1","anchorWechat":"WxgQiao_04","shareImg":"media_id","type":1,"closeLike":0,"closeGoods":0,"closeComment":0}`+"\n")
+		fmt.Fprint(w, `{
+							  "errcode": 0,
+							  "roomId": 33,
+							  "qrcode_url": "https://res.wx.qq.com/op_res/9rSix1dhHfK4rR049JL0PHJ7TpOvkuZ3mE0z7Ou_Etvjf-w1J_jVX0rZqeStLfwh"
+							}`)
+	})
+	got, _, err := client.WXA.CreateRoom(context.Background(), "token", &CreateRoomRequest{
+		Name:         "测试直播间",
+		CoverImg:     "media_id",
+		StartTime:    1588237130,
+		EndTime:      1588237240,
+		AnchorName:   "zefzhang1",
+		AnchorWechat: "WxgQiao_04",
+		ShareImg:     "media_id",
+		Type:         1,
+	})
+	if err != nil {
+		t.Errorf("WXA.CreateRoom retured err: %v", err)
+	}
+	want := &CreatedRoom{
+		RoomID:    33,
+		QRCodeURL: "https://res.wx.qq.com/op_res/9rSix1dhHfK4rR049JL0PHJ7TpOvkuZ3mE0z7Ou_Etvjf-w1J_jVX0rZqeStLfwh",
+	}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("WXA.CreateRoom got %+v, want %+v", got, want)
+	}
+}
+
+func TestWXAService_EditRoom(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/wxaapi/broadcast/room/editroom", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"id":33,"name":"新名称","coverImg":"","startTime":0,"endTime":0,"anchorName":"","anchorWechat":"","shareImg":"","type":0,"closeLike":0,"closeGoods":0,"closeComment":1}`+"\n")
+		fmt.Fprint(w, `{"errcode": 0}`)
+	})
+	_, err := client.WXA.EditRoom(context.Background(), "token", &EditRoomRequest{
+		ID:                33,
+		CreateRoomRequest: CreateRoomRequest{Name: "新名称", CloseComment: 1},
+	})
+	if err != nil {
+		t.Errorf("WXA.EditRoom retured err: %v", err)
+	}
+}
+
+func TestWXAService_LiveRoomUpdates(t *testing.T) {
+	tests := []struct {
+		path string
+		body string
+		call func(s *WXAService) (*Response, error)
+	}{
+		{"deleteroom", `{"id":33}`, func(s *WXAService) (*Response, error) {
+			return s.DeleteRoom(context.Background(), "token", 33)
+		}},
+		{"addassistant", `{"roomId":33,"users":[{"username":"wx","nickname":"nick"}]}`, func(s *WXAService) (*Response, error) {
+			return s.AddAssistant(context.Background(), "token", 33, []*RoomUser{{Username: "wx", Nickname: "nick"}})
+		}},
+		{"modifyassistant", `{"roomId":33,"username":"wx","nickname":"new"}`, func(s *WXAService) (*Response, error) {
+			return s.ModifyAssistant(context.Background(), "token", 33, &RoomUser{Username: "wx", Nickname: "new"})
+		}},
+		{"removeassistant", `{"roomId":33,"username":"wx"}`, func(s *WXAService) (*Response, error) {
+			return s.RemoveAssistant(context.Background(), "token", 33, "wx")
+		}},
+		{"addsubanchor", `{"roomId":33,"username":"wx"}`, func(s *WXAService) (*Response, error) {
+			return s.AddSubAnchor(context.Background(), "token", 33, "wx")
+		}},
+		{"modifysubanchor", `{"roomId":33,"username":"wx2"}`, func(s *WXAService) (*Response, error) {
+			return s.ModifySubAnchor(context.Background(), "token", 33, "wx2")
+		}},
+		{"deletesubanchor", `{"roomId":33}`, func(s *WXAService) (*Response, error) {
+			return s.DeleteSubAnchor(context.Background(), "token", 33)
+		}},
+		{"updatefeedpublic", `{"roomId":33,"isFeedsPublic":1}`, func(s *WXAService) (*Response, error) {
+			return s.UpdateFeedPublic(context.Background(), "token", 33, 1)
+		}},
+		{"updatereplay", `{"roomId":33,"closeReplay":1}`, func(s *WXAService) (*Response, error) {
+			return s.UpdateReplay(context.Background(), "token", 33, 1)
+		}},
+		{"updatekf", `{"roomId":33,"closeKf":0}`, func(s *WXAService) (*Response, error) {
+			return s.UpdateKf(context.Background(), "token", 33, 0)
+		}},
+		{"updatecomment", `{"roomId":33,"banComment":1}`, func(s *WXAService) (*Response, error) {
+			return s.UpdateComment(context.Background(), "token", 33, 1)
+		}},
+	}
+	for _, tt := range tests {
+		client, mux, _, tearDown := setup()
+		mux.HandleFunc("/wxaapi/broadcast/room/"+tt.path, func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodPost)
+			testBody(t, r, tt.body+"\n")
+			fmt.Fprint(w, `{"errcode": 0}`)
+		})
+		if _, err := tt.call(client.WXA); err != nil {
+			t.Errorf("WXA %s retured err: %v", tt.path, err)
+		}
+		tearDown()
+	}
+}
+
+func TestWXAService_GetPushURL(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/wxaapi/broadcast/room/getpushurl", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if got, want := r.URL.Query().Get("roomId"), "33"; got != want {
+			t.Errorf("Request roomId = %v, want %v", got, want)
+		}
+		fmt.Fprint(w, `{"errcode": 0, "pushAddr": "rtmp://xxx.livepush.myqcloud.com/live/xxx"}`)
+	})
+	got, _, err := client.WXA.GetPushURL(context.Background(), "token", 33)
+	if err != nil {
+		t.Errorf("WXA.GetPushURL retured err: %v", err)
+	}
+	want := &PushURL{PushAddr: "rtmp://xxx.livepush.myqcloud.com/live/xxx"}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("WXA.GetPushURL got %+v, want %+v", got, want)
+	}
+}
+
+func TestWXAService_GetSharedCode(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/wxaapi/broadcast/room/getsharedcode", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if got, want := r.URL.Query().Get("params"), `{"a":1}`; got != want {
+			t.Errorf("Request params = %v, want %v", got, want)
+		}
+		fmt.Fprint(w, `{
+							  "errcode": 0,
+							  "cdnUrl": "http://mmbiz.qpic.cn/xxx",
+							  "pagePath": "plugin-private://wx2b03c6e691cd7370/pages/live-player-plugin?room_id=33",
+							  "posterUrl": "http://mmbiz.qpic.cn/yyy"
+							}`)
+	})
+	got, _, err := client.WXA.GetSharedCode(context.Background(), "token", 33, `{"a":1}`)
+	if err != nil {
+		t.Errorf("WXA.GetSharedCode retured err: %v", err)
+	}
+	want := &SharedCode{
+		CDNURL:    "http://mmbiz.qpic.cn/xxx",
+		PagePath:  "plugin-private://wx2b03c6e691cd7370/pages/live-player-plugin?room_id=33",
+		PosterURL: "http://mmbiz.qpic.cn/yyy",
+	}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("WXA.GetSharedCode got %+v, want %+v", got, want)
+	}
+}
+
+func TestWXAService_GetAssistantList(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/wxaapi/broadcast/room/getassistantlist", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{
+							  "errcode": 0,
+							  "list": [
+								{
+								  "timestamp": 1588237130,
+								  "headimg": "http://xxxxxx",
+								  "nickname": "test",
+								  "alias": "wxid",
+								  "openid": "openid"
+								}
+							  ],
+							  "count": 1,
+							  "maxCount": 5
+							}`)
+	})
+	got, _, err := client.WXA.GetAssistantList(context.Background(), "token", 33)
+	if err != nil {
+		t.Errorf("WXA.GetAssistantList retured err: %v", err)
+	}
+	want := &Assistants{
+		List: []*Assistant{
+			{Timestamp: 1588237130, HeadImg: "http://xxxxxx", Nickname: "test", Alias: "wxid", OpenID: "openid"},
+		},
+		Count:    1,
+		MaxCount: 5,
+	}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("WXA.GetAssistantList got %+v, want %+v", got, want)
+	}
+}
+
+func TestWXAService_GetSubAnchor(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/wxaapi/broadcast/room/getsubanchor", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"errcode": 0, "username": "wx"}`)
+	})
+	got, _, err := client.WXA.GetSubAnchor(context.Background(), "token", 33)
+	if err != nil {
+		t.Errorf("WXA.GetSubAnchor retured err: %v", err)
+	}
+	want := &SubAnchor{Username: "wx"}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("WXA.GetSubAnchor got %+v, want %+v", got, want)
+	}
+}
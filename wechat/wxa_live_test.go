@@ -2,6 +2,8 @@ package wechat
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"reflect"
@@ -123,3 +125,93 @@ func TestWXAService_GetLiveInfo_replay(t *testing.T) {
 		t.Errorf("WXA.GetLiveInfo got %+v, want %+v", got, want)
 	}
 }
+
+func TestWXAService_AllLiveRooms(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	const total = 150
+	mux.HandleFunc("/wxa/business/getliveinfo", func(w http.ResponseWriter, r *http.Request) {
+		var req GetLiveInfoRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		if req.Limit != 100 {
+			t.Errorf("Request limit = %d, want 100", req.Limit)
+		}
+		list := `{"errcode": 0, "total": 150, "room_info": [`
+		for i := req.Start; i < req.Start+req.Limit && i < total; i++ {
+			if i > req.Start {
+				list += ","
+			}
+			list += fmt.Sprintf(`{"roomid": %d, "live_status": 103}`, i)
+		}
+		fmt.Fprint(w, list+"]}")
+	})
+	it := client.WXA.AllLiveRooms(context.Background(), "token")
+	n := 0
+	for it.Next() {
+		if got := it.Room().RoomID; got != n {
+			t.Errorf("RoomInfoIterator got room %d, want %d", got, n)
+		}
+		if got := it.Room().LiveStatus; LiveStatus(got) != LiveStatusEnded {
+			t.Errorf("RoomInfoIterator got status %d, want %d", got, LiveStatusEnded)
+		}
+		n++
+	}
+	if err := it.Err(); err != nil {
+		t.Errorf("RoomInfoIterator.Err() = %v", err)
+	}
+	if n != total {
+		t.Errorf("RoomInfoIterator returned %d rooms, want %d", n, total)
+	}
+}
+
+func TestWXAService_AllLiveReplays(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/wxa/business/getliveinfo", func(w http.ResponseWriter, r *http.Request) {
+		testBody(t, r, `{"action":"get_replay","room_id":33,"start":0,"limit":100}`+"\n")
+		fmt.Fprint(w, `{
+							"errcode": 0,
+							"total": 2,
+							"live_replay": [
+								{"media_url": "http://xxxxx.vod2.myqcloud.com/a.mp4"},
+								{"media_url": "http://xxxxx.vod2.myqcloud.com/b.mp4"}
+							]
+						}`)
+	})
+	it := client.WXA.AllLiveReplays(context.Background(), "token", 33)
+	var got []string
+	for it.Next() {
+		got = append(got, it.Replay().MediaURL)
+	}
+	if err := it.Err(); err != nil {
+		t.Errorf("LiveReplayIterator.Err() = %v", err)
+	}
+	want := []string{"http://xxxxx.vod2.myqcloud.com/a.mp4", "http://xxxxx.vod2.myqcloud.com/b.mp4"}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("LiveReplayIterator got %v, want %v", got, want)
+	}
+}
+
+func TestWXAService_AllLiveRooms_canceled(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	requests := 0
+	mux.HandleFunc("/wxa/business/getliveinfo", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		cancel()
+		fmt.Fprint(w, `{"errcode": 0, "total": 200, "room_info": [{"roomid": 1}]}`)
+	})
+	it := client.WXA.AllLiveRooms(ctx, "token")
+	for it.Next() {
+	}
+	if !errors.Is(it.Err(), context.Canceled) {
+		t.Errorf("RoomInfoIterator.Err() = %v, want %v", it.Err(), context.Canceled)
+	}
+	if requests != 1 {
+		t.Errorf("RoomInfoIterator made %d requests, want 1", requests)
+	}
+}
@@ -0,0 +1,156 @@
+package wechat
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// MPLinkStatus represents the status of the link between an Official Account
+// and a mini program.
+type MPLinkStatus int
+
+// MP link statuses.
+const (
+	MPLinkStatusLinked    MPLinkStatus = 1  // 已关联
+	MPLinkStatusPending   MPLinkStatus = 2  // 等待小程序管理员确认中
+	MPLinkStatusRejected  MPLinkStatus = 3  // 小程序管理员拒绝关联
+	MPLinkStatusPendingMP MPLinkStatus = 12 // 等待公众号管理员确认中
+)
+
+// MPLinkFuncInfo represents a feature of a linked mini program.
+type MPLinkFuncInfo struct {
+	Status int    `json:"status"`
+	ID     int    `json:"id"`
+	Name   string `json:"name"`
+}
+
+// MPLinkedWXA represents a mini program linked to an Official Account.
+type MPLinkedWXA struct {
+	Status              MPLinkStatus      `json:"status"`
+	Username            string            `json:"username"`
+	AppID               string            `json:"appid"`
+	Source              string            `json:"source"`
+	Nickname            string            `json:"nickname"`
+	Selected            int               `json:"selected"`
+	NearbyDisplayStatus int               `json:"nearby_display_status"`
+	Released            int               `json:"released"`
+	HeadImgURL          string            `json:"headimg_url"`
+	Email               string            `json:"email"`
+	FuncInfos           []*MPLinkFuncInfo `json:"func_infos"`
+}
+
+// MPLinkedWXAs represents get wxa mp link response.
+type MPLinkedWXAs struct {
+	WXOpens struct {
+		Items []*MPLinkedWXA `json:"items"`
+	} `json:"wxopens"`
+}
+
+// GetWXAMPLink fetch the mini programs linked to the Official Account of
+// token.
+// Wechat API docs:
+// https://developers.weixin.qq.com/doc/oplatform/Third-party_Platforms/Official_Accounts/Mini_Program_Management_Permission.html
+func (s *WXAService) GetWXAMPLink(ctx context.Context, token string) (*MPLinkedWXAs, *Response, error) {
+	u := fmt.Sprintf("cgi-bin/wxopen/wxamplinkget?access_token=%v", token)
+	req, err := s.client.NewRequest(http.MethodPost, u, struct{}{})
+	if err != nil {
+		return nil, nil, err
+	}
+	links := new(MPLinkedWXAs)
+	resp, err := s.client.Do(ctx, req, links)
+	if err != nil {
+		return nil, resp, err
+	}
+	return links, resp, nil
+}
+
+// LinkWXAMPRequest represents request of link wxa mp.
+type LinkWXAMPRequest struct {
+	AppID string
+	// NotifyUsers sends a notification of the link to the followers.
+	NotifyUsers bool
+	// ShowProfile shows the mini program on the profile of the Official
+	// Account.
+	ShowProfile bool
+}
+
+// LinkWXAMP link a mini program to the Official Account of token, the mini
+// program administrator has to confirm it.
+// Wechat API docs:
+// https://developers.weixin.qq.com/doc/oplatform/Third-party_Platforms/Official_Accounts/Mini_Program_Management_Permission.html
+func (s *WXAService) LinkWXAMP(ctx context.Context, token string, r *LinkWXAMPRequest) (*Response, error) {
+	u := fmt.Sprintf("cgi-bin/wxopen/wxamplink?access_token=%v", token)
+	payload := struct {
+		AppID       string `json:"appid"`
+		NotifyUsers string `json:"notify_users"`
+		ShowProfile string `json:"show_profile"`
+	}{AppID: r.AppID, NotifyUsers: boolFlag(r.NotifyUsers), ShowProfile: boolFlag(r.ShowProfile)}
+	req, err := s.client.NewRequest(http.MethodPost, u, payload)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+// UnlinkWXAMP unlink a mini program from the Official Account of token.
+// Wechat API docs:
+// https://developers.weixin.qq.com/doc/oplatform/Third-party_Platforms/Official_Accounts/Mini_Program_Management_Permission.html
+func (s *WXAService) UnlinkWXAMP(ctx context.Context, token, appID string) (*Response, error) {
+	u := fmt.Sprintf("cgi-bin/wxopen/wxampunlink?access_token=%v", token)
+	payload := struct {
+		AppID string `json:"appid"`
+	}{AppID: appID}
+	req, err := s.client.NewRequest(http.MethodPost, u, payload)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+// boolFlag returns "1" if b, else "0".
+func boolFlag(b bool) string {
+	if b {
+		return "1"
+	}
+	return "0"
+}
+
+// maxWXAMPLinkNum is the maximum page size of GetWXAMpLinkForShow.
+const maxWXAMPLinkNum = 20
+
+// WXAMPLinkIterator iterates over all the Official Accounts which can show
+// the mini program.
+type WXAMPLinkIterator struct {
+	it *pageIterator
+}
+
+// Next advances to the next link. It returns false when there are no more
+// links, an error occurred or the context is done.
+func (it *WXAMPLinkIterator) Next() bool { return it.it.next() }
+
+// Link returns the current link.
+func (it *WXAMPLinkIterator) Link() *WXAMPLink { return it.it.cur.(*WXAMPLink) }
+
+// Err returns the error which stopped the iteration, if any.
+func (it *WXAMPLinkIterator) Err() error { return it.it.err }
+
+// AllWXAMpLinksForShow returns an iterator over all the Official Accounts
+// which can show the mini program, walking GetWXAMpLinkForShow until
+// TotalNum links are returned.
+func (s *WXAService) AllWXAMpLinksForShow(ctx context.Context, token string) *WXAMPLinkIterator {
+	page, seen := 0, 0
+	return &WXAMPLinkIterator{it: newPageIterator(ctx, func(ctx context.Context) ([]interface{}, bool, error) {
+		links, _, err := s.GetWXAMpLinkForShow(ctx, token, page, maxWXAMPLinkNum)
+		if err != nil {
+			return nil, false, err
+		}
+		items := make([]interface{}, len(links.BIZInfoList))
+		for i, l := range links.BIZInfoList {
+			items[i] = l
+		}
+		page++
+		seen += len(links.BIZInfoList)
+		return items, seen < links.TotalNum, nil
+	})}
+}
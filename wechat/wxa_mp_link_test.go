@@ -0,0 +1,126 @@
+package wechat
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestWXAService_GetWXAMPLink(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/cgi-bin/wxopen/wxamplinkget", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprint(w, `{
+							  "errcode": 0,
+							  "wxopens": {
+								"items": [
+								  {
+									"status": 1,
+									"username": "gh_xxx",
+									"appid": "wxappid",
+									"source": "SEARCH",
+									"nickname": "小程序",
+									"selected": 1,
+									"nearby_display_status": 1,
+									"released": 1,
+									"headimg_url": "http://mmbiz.qpic.cn/xxx",
+									"email": "a@b.com",
+									"func_infos": [{"status": 0, "id": 8, "name": "小程序"}]
+								  }
+								]
+							  }
+							}`)
+	})
+	got, _, err := client.WXA.GetWXAMPLink(context.Background(), "token")
+	if err != nil {
+		t.Errorf("WXA.GetWXAMPLink retured err: %v", err)
+	}
+	want := new(MPLinkedWXAs)
+	want.WXOpens.Items = []*MPLinkedWXA{{
+		Status:              MPLinkStatusLinked,
+		Username:            "gh_xxx",
+		AppID:               "wxappid",
+		Source:              "SEARCH",
+		Nickname:            "小程序",
+		Selected:            1,
+		NearbyDisplayStatus: 1,
+		Released:            1,
+		HeadImgURL:          "http://mmbiz.qpic.cn/xxx",
+		Email:               "a@b.com",
+		FuncInfos:           []*MPLinkFuncInfo{{ID: 8, Name: "小程序"}},
+	}}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("WXA.GetWXAMPLink got %+v, want %+v", got, want)
+	}
+}
+
+func TestWXAService_LinkWXAMP(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/cgi-bin/wxopen/wxamplink", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"appid":"wxappid","notify_users":"1","show_profile":"0"}`+"\n")
+		fmt.Fprint(w, `{"errcode": 0, "errmsg": "ok"}`)
+	})
+	_, err := client.WXA.LinkWXAMP(context.Background(), "token", &LinkWXAMPRequest{AppID: "wxappid", NotifyUsers: true})
+	if err != nil {
+		t.Errorf("WXA.LinkWXAMP retured err: %v", err)
+	}
+}
+
+func TestWXAService_UnlinkWXAMP(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/cgi-bin/wxopen/wxampunlink", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"appid":"wxappid"}`+"\n")
+		fmt.Fprint(w, `{"errcode": 0, "errmsg": "ok"}`)
+	})
+	_, err := client.WXA.UnlinkWXAMP(context.Background(), "token", "wxappid")
+	if err != nil {
+		t.Errorf("WXA.UnlinkWXAMP retured err: %v", err)
+	}
+}
+
+func TestWXAService_AllWXAMpLinksForShow(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	const total = 45
+	mux.HandleFunc("/wxa/getwxamplinkforshow", func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		num, _ := strconv.Atoi(r.URL.Query().Get("num"))
+		if num != 20 {
+			t.Errorf("Request num = %d, want 20", num)
+		}
+		list := `{"errcode": 0, "total_num": 45, "biz_info_list": [`
+		for i := page * num; i < (page+1)*num && i < total; i++ {
+			if i > page*num {
+				list += ","
+			}
+			list += fmt.Sprintf(`{"appid": "%d"}`, i)
+		}
+		fmt.Fprint(w, list+"]}")
+	})
+	it := client.WXA.AllWXAMpLinksForShow(context.Background(), "token")
+	n := 0
+	for it.Next() {
+		if got, want := it.Link().AppID, strconv.Itoa(n); got != want {
+			t.Errorf("WXAMPLinkIterator got link %s, want %s", got, want)
+		}
+		n++
+	}
+	if err := it.Err(); err != nil {
+		t.Errorf("WXAMPLinkIterator.Err() = %v", err)
+	}
+	if n != total {
+		t.Errorf("WXAMPLinkIterator returned %d links, want %d", n, total)
+	}
+}
@@ -11,12 +11,24 @@ type PluginRequest struct {
 	Action      string `json:"action"`
 	PluginAppID string `json:"plugin_appid,omitempty"`
 	UserVersion string `json:"user_version,omitempty"`
+	Reason      string `json:"reason,omitempty"`
 }
 
+// PluginStatus represents the apply status of a plugin.
+type PluginStatus int
+
+// Plugin statuses.
+const (
+	PluginStatusApplying PluginStatus = 1 // 申请中
+	PluginStatusApproved PluginStatus = 2 // 申请通过
+	PluginStatusRejected PluginStatus = 3 // 被拒绝
+	PluginStatusTimeout  PluginStatus = 4 // 已超时
+)
+
 // Plugin represents plugin struct
 type Plugin struct {
 	AppID      string `json:"appid"`
-	Status     int    `json:"status"`
+	Status     int    `json:"status"` // a PluginStatus
 	Nickname   string `json:"nickname"`
 	HeadImgURL string `json:"headimgurl"`
 }
@@ -43,3 +55,155 @@ func (s *WXAService) Plugin(ctx context.Context, token string, r *PluginRequest)
 	}
 	return pluginResp, resp, nil
 }
+
+// ApplyPlugin apply for a plugin.
+// Wechat API docs:
+// https://developers.weixin.qq.com/doc/oplatform/Third-party_Platforms/Mini_Programs/Plug-ins_Management.html
+func (s *WXAService) ApplyPlugin(ctx context.Context, token, pluginAppID, reason string) (*Response, error) {
+	_, resp, err := s.Plugin(ctx, token, &PluginRequest{Action: "apply", PluginAppID: pluginAppID, Reason: reason})
+	return resp, err
+}
+
+// ListPlugins fetch the plugins added to the app.
+// Wechat API docs:
+// https://developers.weixin.qq.com/doc/oplatform/Third-party_Platforms/Mini_Programs/Plug-ins_Management.html
+func (s *WXAService) ListPlugins(ctx context.Context, token string) ([]*Plugin, *Response, error) {
+	pluginResp, resp, err := s.Plugin(ctx, token, &PluginRequest{Action: "list"})
+	if err != nil {
+		return nil, resp, err
+	}
+	return pluginResp.PluginList, resp, nil
+}
+
+// UnbindPlugin remove a plugin from the app.
+// Wechat API docs:
+// https://developers.weixin.qq.com/doc/oplatform/Third-party_Platforms/Mini_Programs/Plug-ins_Management.html
+func (s *WXAService) UnbindPlugin(ctx context.Context, token, pluginAppID string) (*Response, error) {
+	_, resp, err := s.Plugin(ctx, token, &PluginRequest{Action: "unbind", PluginAppID: pluginAppID})
+	return resp, err
+}
+
+// UpdatePlugin update a plugin to the given version.
+// Wechat API docs:
+// https://developers.weixin.qq.com/doc/oplatform/Third-party_Platforms/Mini_Programs/Plug-ins_Management.html
+func (s *WXAService) UpdatePlugin(ctx context.Context, token, pluginAppID, userVersion string) (*Response, error) {
+	_, resp, err := s.Plugin(ctx, token, &PluginRequest{Action: "update", PluginAppID: pluginAppID, UserVersion: userVersion})
+	return resp, err
+}
+
+// DevPluginRequest represents plugin developer request.
+type DevPluginRequest struct {
+	Action string `json:"action"`
+	AppID  string `json:"appid,omitempty"`
+	Page   int    `json:"page,omitempty"`
+	Num    int    `json:"num,omitempty"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// PluginCategory represents the category of an app applying for a plugin.
+type PluginCategory struct {
+	First  string `json:"first"`
+	Second string `json:"second"`
+}
+
+// DevPluginApply represents an app applying for our plugin.
+type DevPluginApply struct {
+	AppID      string            `json:"appid"`
+	Status     PluginStatus      `json:"status"`
+	Nickname   string            `json:"nickname"`
+	HeadImgURL string            `json:"headimgurl"`
+	Categories []*PluginCategory `json:"categories,omitempty"`
+	CreateTime string            `json:"create_time,omitempty"`
+	ApplyURL   string            `json:"apply_url,omitempty"`
+	Reason     string            `json:"reason,omitempty"`
+}
+
+// DevPluginResponse represents plugin developer response.
+type DevPluginResponse struct {
+	ApplyList []*DevPluginApply `json:"apply_list"`
+}
+
+// DevPlugin represents manage the applies to our plugin, token is the
+// access token of the plugin.
+// Wechat API docs:
+// https://developers.weixin.qq.com/doc/oplatform/Third-party_Platforms/Mini_Programs/Plug-ins_Management.html
+func (s *WXAService) DevPlugin(ctx context.Context, token string, r *DevPluginRequest) (*DevPluginResponse, *Response, error) {
+	u := fmt.Sprintf("wxa/devplugin?access_token=%v", token)
+	req, err := s.client.NewRequest(http.MethodPost, u, r)
+	if err != nil {
+		return nil, nil, err
+	}
+	devResp := new(DevPluginResponse)
+	resp, err := s.client.Do(ctx, req, devResp)
+	if err != nil {
+		return nil, resp, err
+	}
+	return devResp, resp, nil
+}
+
+// ListDevPluginApplies fetch a page of the applies to our plugin, page
+// starts from 1.
+func (s *WXAService) ListDevPluginApplies(ctx context.Context, token string, page, num int) ([]*DevPluginApply, *Response, error) {
+	devResp, resp, err := s.DevPlugin(ctx, token, &DevPluginRequest{Action: "dev_apply_list", Page: page, Num: num})
+	if err != nil {
+		return nil, resp, err
+	}
+	return devResp.ApplyList, resp, nil
+}
+
+// AgreeDevPlugin agree an app to use our plugin.
+func (s *WXAService) AgreeDevPlugin(ctx context.Context, token, appID string) (*Response, error) {
+	_, resp, err := s.DevPlugin(ctx, token, &DevPluginRequest{Action: "dev_agree", AppID: appID})
+	return resp, err
+}
+
+// RefuseDevPlugin refuse an app to use our plugin.
+func (s *WXAService) RefuseDevPlugin(ctx context.Context, token, appID, reason string) (*Response, error) {
+	_, resp, err := s.DevPlugin(ctx, token, &DevPluginRequest{Action: "dev_refuse", AppID: appID, Reason: reason})
+	return resp, err
+}
+
+// DeleteDevPlugin delete a refused or timed out apply to our plugin.
+func (s *WXAService) DeleteDevPlugin(ctx context.Context, token, appID string) (*Response, error) {
+	_, resp, err := s.DevPlugin(ctx, token, &DevPluginRequest{Action: "dev_delete", AppID: appID})
+	return resp, err
+}
+
+// maxDevPluginApplyNum is the maximum page size of dev_apply_list.
+const maxDevPluginApplyNum = 100
+
+// DevPluginApplyIterator iterates over all the applies to our plugin.
+type DevPluginApplyIterator struct {
+	it *pageIterator
+}
+
+// Next advances to the next apply. It returns false when there are no more
+// applies, an error occurred or the context is done.
+func (it *DevPluginApplyIterator) Next() bool { return it.it.next() }
+
+// Apply returns the current apply.
+func (it *DevPluginApplyIterator) Apply() *DevPluginApply { return it.it.cur.(*DevPluginApply) }
+
+// Err returns the error which stopped the iteration, if any.
+func (it *DevPluginApplyIterator) Err() error { return it.it.err }
+
+// DevPluginApplies returns an iterator over all the applies to our plugin,
+// fetching num applies per page.
+func (s *WXAService) DevPluginApplies(ctx context.Context, token string, num int) *DevPluginApplyIterator {
+	if num <= 0 || num > maxDevPluginApplyNum {
+		num = maxDevPluginApplyNum
+	}
+	page := 0
+	return &DevPluginApplyIterator{it: newPageIterator(ctx, func(ctx context.Context) ([]interface{}, bool, error) {
+		page++
+		applies, _, err := s.ListDevPluginApplies(ctx, token, page, num)
+		if err != nil {
+			return nil, false, err
+		}
+		items := make([]interface{}, len(applies))
+		for i, a := range applies {
+			items[i] = a
+		}
+		return items, len(applies) == num, nil
+	})}
+}
@@ -2,6 +2,7 @@ package wechat
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"reflect"
@@ -82,3 +83,193 @@ func TestWXAService_Plugin_List(t *testing.T) {
 	}
 
 }
+
+func TestWXAService_ApplyPlugin(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/wxa/plugin", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"action":"apply","plugin_appid":"aaaa","reason":"need it"}`+"\n")
+		fmt.Fprint(w, `{"errcode":0,"errmsg":"ok"}`)
+	})
+	if _, err := client.WXA.ApplyPlugin(context.Background(), "token", "aaaa", "need it"); err != nil {
+		t.Errorf("WXA.ApplyPlugin retured err: %v", err)
+	}
+}
+
+func TestWXAService_ListPlugins(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/wxa/plugin", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"action":"list"}`+"\n")
+		fmt.Fprint(w, `{"errcode":0,"errmsg":"ok","plugin_list":[{"appid":"aaaa","status":2,"nickname":"插件昵称","headimgurl":"http://plugin.qq.com"}]}`)
+	})
+	got, _, err := client.WXA.ListPlugins(context.Background(), "token")
+	if err != nil {
+		t.Errorf("WXA.ListPlugins retured err: %v", err)
+	}
+	want := []*Plugin{{AppID: "aaaa", Status: int(PluginStatusApproved), Nickname: "插件昵称", HeadImgURL: "http://plugin.qq.com"}}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("WXA.ListPlugins got %+v, want %+v", got, want)
+	}
+}
+
+func TestWXAService_UnbindPlugin(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/wxa/plugin", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"action":"unbind","plugin_appid":"aaaa"}`+"\n")
+		fmt.Fprint(w, `{"errcode":0,"errmsg":"ok"}`)
+	})
+	if _, err := client.WXA.UnbindPlugin(context.Background(), "token", "aaaa"); err != nil {
+		t.Errorf("WXA.UnbindPlugin retured err: %v", err)
+	}
+}
+
+func TestWXAService_UpdatePlugin(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/wxa/plugin", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"action":"update","plugin_appid":"aaaa","user_version":"1.0.1"}`+"\n")
+		fmt.Fprint(w, `{"errcode":0,"errmsg":"ok"}`)
+	})
+	if _, err := client.WXA.UpdatePlugin(context.Background(), "token", "aaaa", "1.0.1"); err != nil {
+		t.Errorf("WXA.UpdatePlugin retured err: %v", err)
+	}
+}
+
+func TestWXAService_ListDevPluginApplies(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/wxa/devplugin", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"action":"dev_apply_list","page":1,"num":10}`+"\n")
+		fmt.Fprint(w, `{
+							  "errcode": 0,
+							  "errmsg": "ok",
+							  "apply_list": [
+								{
+								  "appid": "xxxx",
+								  "status": 1,
+								  "nickname": "名称",
+								  "headimgurl": "**********",
+								  "reason": "polo has gone",
+								  "apply_url": "*******",
+								  "create_time": "1536305096",
+								  "categories": [{"first": "IT科技", "second": "硬件与设备"}]
+								}
+							  ]
+							}`)
+	})
+	got, _, err := client.WXA.ListDevPluginApplies(context.Background(), "token", 1, 10)
+	if err != nil {
+		t.Errorf("WXA.ListDevPluginApplies retured err: %v", err)
+	}
+	want := []*DevPluginApply{{
+		AppID:      "xxxx",
+		Status:     PluginStatusApplying,
+		Nickname:   "名称",
+		HeadImgURL: "**********",
+		Reason:     "polo has gone",
+		ApplyURL:   "*******",
+		CreateTime: "1536305096",
+		Categories: []*PluginCategory{{First: "IT科技", Second: "硬件与设备"}},
+	}}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("WXA.ListDevPluginApplies got %+v, want %+v", got, want)
+	}
+}
+
+func TestWXAService_AgreeDevPlugin(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/wxa/devplugin", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"action":"dev_agree","appid":"xxxx"}`+"\n")
+		fmt.Fprint(w, `{"errcode":0,"errmsg":"ok"}`)
+	})
+	if _, err := client.WXA.AgreeDevPlugin(context.Background(), "token", "xxxx"); err != nil {
+		t.Errorf("WXA.AgreeDevPlugin retured err: %v", err)
+	}
+}
+
+func TestWXAService_RefuseDevPlugin(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/wxa/devplugin", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"action":"dev_refuse","appid":"xxxx","reason":"no"}`+"\n")
+		fmt.Fprint(w, `{"errcode":0,"errmsg":"ok"}`)
+	})
+	if _, err := client.WXA.RefuseDevPlugin(context.Background(), "token", "xxxx", "no"); err != nil {
+		t.Errorf("WXA.RefuseDevPlugin retured err: %v", err)
+	}
+}
+
+func TestWXAService_DeleteDevPlugin(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/wxa/devplugin", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"action":"dev_delete","appid":"xxxx"}`+"\n")
+		fmt.Fprint(w, `{"errcode":0,"errmsg":"ok"}`)
+	})
+	if _, err := client.WXA.DeleteDevPlugin(context.Background(), "token", "xxxx"); err != nil {
+		t.Errorf("WXA.DeleteDevPlugin retured err: %v", err)
+	}
+}
+
+func TestWXAService_DevPluginApplies(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/wxa/devplugin", func(w http.ResponseWriter, r *http.Request) {
+		req := new(DevPluginRequest)
+		json.NewDecoder(r.Body).Decode(req)
+		switch req.Page {
+		case 1:
+			fmt.Fprint(w, `{"errcode":0,"errmsg":"ok","apply_list":[{"appid":"a"},{"appid":"b"}]}`)
+		case 2:
+			fmt.Fprint(w, `{"errcode":0,"errmsg":"ok","apply_list":[{"appid":"c"}]}`)
+		default:
+			t.Errorf("Unexpected page %d", req.Page)
+		}
+	})
+	it := client.WXA.DevPluginApplies(context.Background(), "token", 2)
+	var got []string
+	for it.Next() {
+		got = append(got, it.Apply().AppID)
+	}
+	if err := it.Err(); err != nil {
+		t.Errorf("DevPluginApplyIterator.Err() = %v", err)
+	}
+	if want := []string{"a", "b", "c"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("DevPluginApplyIterator got %v, want %v", got, want)
+	}
+}
+
+func TestWXAService_DevPluginApplies_canceled(t *testing.T) {
+	client, _, _, tearDown := setup()
+	defer tearDown()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	it := client.WXA.DevPluginApplies(ctx, "token", 2)
+	if it.Next() {
+		t.Error("DevPluginApplyIterator.Next() = true, want false")
+	}
+	if err := it.Err(); err != context.Canceled {
+		t.Errorf("DevPluginApplyIterator.Err() = %v, want %v", err, context.Canceled)
+	}
+}
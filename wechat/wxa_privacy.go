@@ -0,0 +1,155 @@
+package wechat
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// CodePrivacyInfo represents the privacy interfaces used by the committed code.
+type CodePrivacyInfo struct {
+	WithoutAuthList []string `json:"without_auth_list"`
+	WithoutConfList []string `json:"without_conf_list"`
+}
+
+// GetCodePrivacyInfo fetch the privacy interfaces used by the committed code.
+//
+// Wechat API docs:
+// https://developers.weixin.qq.com/doc/oplatform/Third-party_Platforms/2.0/api/code/get_code_privacy_info.html
+func (s *WXAService) GetCodePrivacyInfo(ctx context.Context, token string) (*CodePrivacyInfo, *Response, error) {
+	u := fmt.Sprintf("wxa/security/get_code_privacy_info?access_token=%v", token)
+	req, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	info := new(CodePrivacyInfo)
+	resp, err := s.client.Do(ctx, req, info)
+	if err != nil {
+		return nil, resp, err
+	}
+	return info, resp, nil
+}
+
+// PrivacyVersion represents the version of a privacy setting.
+type PrivacyVersion int
+
+// Privacy setting versions.
+const (
+	PrivacyVersionCurrent PrivacyVersion = 1
+	PrivacyVersionDevelop PrivacyVersion = 2
+)
+
+// PrivacySetting represents a single privacy setting item.
+type PrivacySetting struct {
+	PrivacyKey   string `json:"privacy_key"`
+	PrivacyText  string `json:"privacy_text"`
+	PrivacyLabel string `json:"privacy_label,omitempty"`
+}
+
+// PrivacyOwnerSetting represents the owner info of a privacy setting.
+type PrivacyOwnerSetting struct {
+	ContactEmail         string `json:"contact_email,omitempty"`
+	ContactPhone         string `json:"contact_phone,omitempty"`
+	ContactQQ            string `json:"contact_qq,omitempty"`
+	ContactWeixin        string `json:"contact_weixin,omitempty"`
+	ExtFileMediaID       string `json:"ext_file_media_id,omitempty"`
+	NoticeMethod         string `json:"notice_method"`
+	StoreExpireTimestamp string `json:"store_expire_timestamp,omitempty"`
+	StoreRegion          int    `json:"store_region,omitempty"`
+}
+
+// SDKPrivacyInfo represents the privacy info of a third-party SDK.
+type SDKPrivacyInfo struct {
+	SDKName    string            `json:"sdk_name"`
+	SDKBizName string            `json:"sdk_biz_name"`
+	SDKList    []*PrivacySetting `json:"sdk_list"`
+}
+
+// SetPrivacySettingRequest represents request of set privacy setting.
+type SetPrivacySettingRequest struct {
+	PrivacyVersion     PrivacyVersion       `json:"privacy_ver,omitempty"`
+	OwnerSetting       *PrivacyOwnerSetting `json:"owner_setting"`
+	SettingList        []*PrivacySetting    `json:"setting_list,omitempty"`
+	SDKPrivacyInfoList []*SDKPrivacyInfo    `json:"sdk_privacy_info_list,omitempty"`
+}
+
+// SetPrivacySetting set the privacy setting.
+//
+// Wechat API docs:
+// https://developers.weixin.qq.com/doc/oplatform/Third-party_Platforms/2.0/api/privacy_config/set_privacy_setting.html
+func (s *WXAService) SetPrivacySetting(ctx context.Context, token string, r *SetPrivacySettingRequest) (*Response, error) {
+	u := fmt.Sprintf("cgi-bin/component/setprivacysetting?access_token=%v", token)
+	req, err := s.client.NewRequest(http.MethodPost, u, r)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+// PrivacyDesc represents the description of a privacy key.
+type PrivacyDesc struct {
+	PrivacyKey  string `json:"privacy_key"`
+	PrivacyDesc string `json:"privacy_desc"`
+}
+
+// PrivacyDescList represents the privacy description list.
+type PrivacyDescList struct {
+	PrivacyDescList []*PrivacyDesc `json:"privacy_desc_list"`
+}
+
+// PrivacySettingInfo represents get privacy setting response.
+type PrivacySettingInfo struct {
+	CodeExist          int                  `json:"code_exist"`
+	PrivacyList        []string             `json:"privacy_list"`
+	SettingList        []*PrivacySetting    `json:"setting_list"`
+	UpdateTime         int64                `json:"update_time"`
+	OwnerSetting       *PrivacyOwnerSetting `json:"owner_setting"`
+	PrivacyDesc        *PrivacyDescList     `json:"privacy_desc"`
+	SDKPrivacyInfoList []*SDKPrivacyInfo    `json:"sdk_privacy_info_list,omitempty"`
+}
+
+// GetPrivacySetting fetch the privacy setting of given version.
+//
+// Wechat API docs:
+// https://developers.weixin.qq.com/doc/oplatform/Third-party_Platforms/2.0/api/privacy_config/get_privacy_setting.html
+func (s *WXAService) GetPrivacySetting(ctx context.Context, token string, version PrivacyVersion) (*PrivacySettingInfo, *Response, error) {
+	u := fmt.Sprintf("cgi-bin/component/getprivacysetting?access_token=%v", token)
+	payload := struct {
+		PrivacyVersion PrivacyVersion `json:"privacy_ver,omitempty"`
+	}{PrivacyVersion: version}
+	req, err := s.client.NewRequest(http.MethodPost, u, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+	info := new(PrivacySettingInfo)
+	resp, err := s.client.Do(ctx, req, info)
+	if err != nil {
+		return nil, resp, err
+	}
+	return info, resp, nil
+}
+
+// PrivacyExtFile represents upload privacy ext file response.
+type PrivacyExtFile struct {
+	ExtFileMediaID string `json:"ext_file_media_id"`
+}
+
+// UploadPrivacyExtFile upload a privacy ext file, the returned media id is
+// used as PrivacyOwnerSetting.ExtFileMediaID.
+//
+// Wechat API docs:
+// https://developers.weixin.qq.com/doc/oplatform/Third-party_Platforms/2.0/api/privacy_config/upload_privacy_exfile.html
+func (s *WXAService) UploadPrivacyExtFile(ctx context.Context, token, fileName string, file io.Reader) (*PrivacyExtFile, *Response, error) {
+	u := fmt.Sprintf("cgi-bin/component/uploadprivacyextfile?access_token=%v", token)
+	req, err := s.client.NewUploadRequest(u, "file", fileName, file)
+	if err != nil {
+		return nil, nil, err
+	}
+	extFile := new(PrivacyExtFile)
+	resp, err := s.client.Do(ctx, req, extFile)
+	if err != nil {
+		return nil, resp, err
+	}
+	return extFile, resp, nil
+}
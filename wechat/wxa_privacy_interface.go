@@ -0,0 +1,133 @@
+package wechat
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// PrivacyInterfaceStatus represents the apply status of a privacy interface.
+type PrivacyInterfaceStatus int
+
+// Privacy interface statuses.
+const (
+	PrivacyInterfaceStatusToApply     PrivacyInterfaceStatus = 1 // 待申请开通
+	PrivacyInterfaceStatusNoAuth      PrivacyInterfaceStatus = 2 // 无权限
+	PrivacyInterfaceStatusApplying    PrivacyInterfaceStatus = 3 // 申请中
+	PrivacyInterfaceStatusApplyFailed PrivacyInterfaceStatus = 4 // 申请失败
+	PrivacyInterfaceStatusOpened      PrivacyInterfaceStatus = 5 // 已开通
+)
+
+// PrivacyInterface represents a privacy interface, such as wx.getLocation.
+type PrivacyInterface struct {
+	APIName    string                 `json:"api_name"`
+	APIChName  string                 `json:"api_ch_name"`
+	APIDesc    string                 `json:"api_desc"`
+	APILink    string                 `json:"api_link,omitempty"`
+	GroupName  string                 `json:"group_name,omitempty"`
+	Status     PrivacyInterfaceStatus `json:"status"`
+	ApplyTime  int64                  `json:"apply_time,omitempty"`
+	AuditID    int64                  `json:"audit_id,omitempty"`
+	FailReason string                 `json:"fail_reason,omitempty"`
+}
+
+// PrivacyInterfaces represents a privacy interface list.
+type PrivacyInterfaces struct {
+	InterfaceList []*PrivacyInterface `json:"interface_list"`
+}
+
+// GetPrivacyInterface fetch the privacy interface list.
+//
+// Wechat API docs:
+// https://developers.weixin.qq.com/doc/oplatform/Third-party_Platforms/2.0/api/apply_api/get_privacy_interface.html
+func (s *WXAService) GetPrivacyInterface(ctx context.Context, token string) (*PrivacyInterfaces, *Response, error) {
+	u := fmt.Sprintf("wxa/security/get_privacy_interface?access_token=%v", token)
+	req, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	interfaces := new(PrivacyInterfaces)
+	resp, err := s.client.Do(ctx, req, interfaces)
+	if err != nil {
+		return nil, resp, err
+	}
+	return interfaces, resp, nil
+}
+
+// ApplyPrivacyInterfaceRequest represents request of apply privacy interface.
+type ApplyPrivacyInterfaceRequest struct {
+	APIName   string   `json:"api_name"`
+	Content   string   `json:"content"`
+	URLList   []string `json:"url_list,omitempty"`
+	PicList   []string `json:"pic_list,omitempty"`
+	VideoList []string `json:"video_list,omitempty"`
+}
+
+// PrivacyInterfaceAudit represents apply privacy interface response.
+type PrivacyInterfaceAudit struct {
+	AuditID int64 `json:"audit_id"`
+}
+
+// ApplyPrivacyInterface apply for a privacy interface.
+//
+// Wechat API docs:
+// https://developers.weixin.qq.com/doc/oplatform/Third-party_Platforms/2.0/api/apply_api/apply_privacy_interface.html
+func (s *WXAService) ApplyPrivacyInterface(ctx context.Context, token string, r *ApplyPrivacyInterfaceRequest) (*PrivacyInterfaceAudit, *Response, error) {
+	u := fmt.Sprintf("wxa/security/apply_privacy_interface?access_token=%v", token)
+	req, err := s.client.NewRequest(http.MethodPost, u, r)
+	if err != nil {
+		return nil, nil, err
+	}
+	audit := new(PrivacyInterfaceAudit)
+	resp, err := s.client.Do(ctx, req, audit)
+	if err != nil {
+		return nil, resp, err
+	}
+	return audit, resp, nil
+}
+
+// PrivacyInterfaceApplyResult represents the result of applying one interface
+// in ApplyPrivacyInterfaces.
+type PrivacyInterfaceApplyResult struct {
+	APIName string
+	// Skipped is true if the interface was already opened or applying.
+	Skipped bool
+	AuditID int64
+	Err     error
+}
+
+// ApplyPrivacyInterfaces apply for several privacy interfaces at once. The
+// current interface list is fetched first, and interfaces which are already
+// opened or under audit are skipped. A failure on one interface does not stop
+// the others, it is reported in the corresponding result.
+func (s *WXAService) ApplyPrivacyInterfaces(ctx context.Context, token string, rs []*ApplyPrivacyInterfaceRequest) ([]*PrivacyInterfaceApplyResult, error) {
+	interfaces, _, err := s.GetPrivacyInterface(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	status := make(map[string]PrivacyInterfaceStatus, len(interfaces.InterfaceList))
+	for _, i := range interfaces.InterfaceList {
+		status[i.APIName] = i.Status
+	}
+
+	results := make([]*PrivacyInterfaceApplyResult, 0, len(rs))
+	for _, r := range rs {
+		result := &PrivacyInterfaceApplyResult{APIName: r.APIName}
+		results = append(results, result)
+		switch status[r.APIName] {
+		case PrivacyInterfaceStatusOpened, PrivacyInterfaceStatusApplying:
+			result.Skipped = true
+			continue
+		}
+		audit, _, err := s.ApplyPrivacyInterface(ctx, token, r)
+		if err != nil {
+			result.Err = err
+			if ctx.Err() != nil {
+				return results, ctx.Err()
+			}
+			continue
+		}
+		result.AuditID = audit.AuditID
+	}
+	return results, nil
+}
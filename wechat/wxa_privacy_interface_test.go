@@ -0,0 +1,131 @@
+package wechat
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestWXAService_GetPrivacyInterface(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/wxa/security/get_privacy_interface", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{
+							  "errcode": 0,
+							  "errmsg": "ok",
+							  "interface_list": [
+								{
+								  "api_name": "wx.getLocation",
+								  "api_ch_name": "获取当前的地理位置",
+								  "api_desc": "获取当前的地理位置、速度",
+								  "status": 4,
+								  "apply_time": 1630582373,
+								  "audit_id": 414008922,
+								  "fail_reason": "场景不符"
+								}
+							  ]
+							}`)
+	})
+	got, _, err := client.WXA.GetPrivacyInterface(context.Background(), "token")
+	if err != nil {
+		t.Errorf("WXA.GetPrivacyInterface retured err: %v", err)
+	}
+	want := &PrivacyInterfaces{InterfaceList: []*PrivacyInterface{
+		{
+			APIName:    "wx.getLocation",
+			APIChName:  "获取当前的地理位置",
+			APIDesc:    "获取当前的地理位置、速度",
+			Status:     PrivacyInterfaceStatusApplyFailed,
+			ApplyTime:  1630582373,
+			AuditID:    414008922,
+			FailReason: "场景不符",
+		},
+	}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("WXA.GetPrivacyInterface got %+v, want %+v", got, want)
+	}
+}
+
+func TestWXAService_ApplyPrivacyInterface(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	req := &ApplyPrivacyInterfaceRequest{
+		APIName:   "wx.chooseAddress",
+		Content:   "填写收货地址",
+		URLList:   []string{"https://example.com/demo"},
+		VideoList: []string{"https://example.com/demo.mp4"},
+	}
+	mux.HandleFunc("/wxa/security/apply_privacy_interface", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		got := new(ApplyPrivacyInterfaceRequest)
+		json.NewDecoder(r.Body).Decode(got)
+		if !reflect.DeepEqual(got, req) {
+			t.Errorf("Request body = %+v, want %+v", got, req)
+		}
+		fmt.Fprint(w, `{
+							  "errcode": 0,
+							  "errmsg": "ok",
+							  "audit_id": 123
+							}`)
+	})
+	got, _, err := client.WXA.ApplyPrivacyInterface(context.Background(), "token", req)
+	if err != nil {
+		t.Errorf("WXA.ApplyPrivacyInterface retured err: %v", err)
+	}
+	want := &PrivacyInterfaceAudit{AuditID: 123}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("WXA.ApplyPrivacyInterface got %+v, want %+v", got, want)
+	}
+}
+
+func TestWXAService_ApplyPrivacyInterfaces(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/wxa/security/get_privacy_interface", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{
+							  "errcode": 0,
+							  "errmsg": "ok",
+							  "interface_list": [
+								{"api_name": "wx.getLocation", "status": 5},
+								{"api_name": "wx.chooseAddress", "status": 1},
+								{"api_name": "wx.chooseLocation", "status": 1}
+							  ]
+							}`)
+	})
+	mux.HandleFunc("/wxa/security/apply_privacy_interface", func(w http.ResponseWriter, r *http.Request) {
+		req := new(ApplyPrivacyInterfaceRequest)
+		json.NewDecoder(r.Body).Decode(req)
+		if req.APIName == "wx.chooseLocation" {
+			fmt.Fprint(w, `{"errcode": 61031, "errmsg": "invalid content"}`)
+			return
+		}
+		fmt.Fprint(w, `{"errcode": 0, "errmsg": "ok", "audit_id": 1}`)
+	})
+	got, err := client.WXA.ApplyPrivacyInterfaces(context.Background(), "token", []*ApplyPrivacyInterfaceRequest{
+		{APIName: "wx.getLocation", Content: "c"},
+		{APIName: "wx.chooseAddress", Content: "c"},
+		{APIName: "wx.chooseLocation", Content: "c"},
+	})
+	if err != nil {
+		t.Fatalf("WXA.ApplyPrivacyInterfaces retured err: %v", err)
+	}
+	if len(got) != 3 {
+		t.Fatalf("WXA.ApplyPrivacyInterfaces returned %d results, want 3", len(got))
+	}
+	if !got[0].Skipped {
+		t.Errorf("WXA.ApplyPrivacyInterfaces result[0] = %+v, want skipped", got[0])
+	}
+	if got[1].Skipped || got[1].AuditID != 1 || got[1].Err != nil {
+		t.Errorf("WXA.ApplyPrivacyInterfaces result[1] = %+v, want audit id 1", got[1])
+	}
+	if got[2].Err == nil {
+		t.Errorf("WXA.ApplyPrivacyInterfaces result[2] = %+v, want error", got[2])
+	}
+}
@@ -0,0 +1,150 @@
+package wechat
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestWXAService_GetCodePrivacyInfo(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/wxa/security/get_code_privacy_info", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{
+							  "errcode": 0,
+							  "errmsg": "ok",
+							  "without_auth_list": ["wx.chooseLocation"],
+							  "without_conf_list": ["wx.getLocation"]
+							}`)
+	})
+	got, _, err := client.WXA.GetCodePrivacyInfo(context.Background(), "token")
+	if err != nil {
+		t.Errorf("WXA.GetCodePrivacyInfo retured err: %v", err)
+	}
+	want := &CodePrivacyInfo{
+		WithoutAuthList: []string{"wx.chooseLocation"},
+		WithoutConfList: []string{"wx.getLocation"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("WXA.GetCodePrivacyInfo got %+v, want %+v", got, want)
+	}
+}
+
+func TestWXAService_SetPrivacySetting(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	req := &SetPrivacySettingRequest{
+		PrivacyVersion: PrivacyVersionDevelop,
+		OwnerSetting: &PrivacyOwnerSetting{
+			ContactEmail: "pony@qq.com",
+			NoticeMethod: "弹窗提示",
+		},
+		SettingList: []*PrivacySetting{
+			{PrivacyKey: "UserInfo", PrivacyText: "登录"},
+		},
+		SDKPrivacyInfoList: []*SDKPrivacyInfo{
+			{
+				SDKName:    "sdk",
+				SDKBizName: "biz",
+				SDKList:    []*PrivacySetting{{PrivacyKey: "Location", PrivacyText: "定位"}},
+			},
+		},
+	}
+	mux.HandleFunc("/cgi-bin/component/setprivacysetting", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		got := new(SetPrivacySettingRequest)
+		json.NewDecoder(r.Body).Decode(got)
+		if !reflect.DeepEqual(got, req) {
+			t.Errorf("Request body = %+v, want %+v", got, req)
+		}
+		fmt.Fprint(w, `{
+							  "errcode": 0,
+							  "errmsg": "ok"
+							}`)
+	})
+	_, err := client.WXA.SetPrivacySetting(context.Background(), "token", req)
+	if err != nil {
+		t.Errorf("WXA.SetPrivacySetting retured err: %v", err)
+	}
+}
+
+func TestWXAService_GetPrivacySetting(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/cgi-bin/component/getprivacysetting", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		body, _ := ioutil.ReadAll(r.Body)
+		if got, want := string(body), `{"privacy_ver":2}`+"\n"; got != want {
+			t.Errorf("Request body = %v, want %v", got, want)
+		}
+		fmt.Fprint(w, `{
+							  "errcode": 0,
+							  "errmsg": "ok",
+							  "code_exist": 1,
+							  "privacy_list": ["UserInfo", "Location"],
+							  "setting_list": [{"privacy_key": "UserInfo", "privacy_text": "登录", "privacy_label": "用户信息"}],
+							  "update_time": 1645115003,
+							  "owner_setting": {"contact_qq": "123", "notice_method": "弹窗提示"},
+							  "privacy_desc": {"privacy_desc_list": [{"privacy_key": "UserInfo", "privacy_desc": "用户信息"}]}
+							}`)
+	})
+	got, _, err := client.WXA.GetPrivacySetting(context.Background(), "token", PrivacyVersionDevelop)
+	if err != nil {
+		t.Errorf("WXA.GetPrivacySetting retured err: %v", err)
+	}
+	want := &PrivacySettingInfo{
+		CodeExist:   1,
+		PrivacyList: []string{"UserInfo", "Location"},
+		SettingList: []*PrivacySetting{
+			{PrivacyKey: "UserInfo", PrivacyText: "登录", PrivacyLabel: "用户信息"},
+		},
+		UpdateTime:   1645115003,
+		OwnerSetting: &PrivacyOwnerSetting{ContactQQ: "123", NoticeMethod: "弹窗提示"},
+		PrivacyDesc: &PrivacyDescList{PrivacyDescList: []*PrivacyDesc{
+			{PrivacyKey: "UserInfo", PrivacyDesc: "用户信息"},
+		}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("WXA.GetPrivacySetting got %+v, want %+v", got, want)
+	}
+}
+
+func TestWXAService_UploadPrivacyExtFile(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/cgi-bin/component/uploadprivacyextfile", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		f, _, err := r.FormFile("file")
+		if err != nil {
+			t.Fatalf("FormFile returned error: %v", err)
+		}
+		defer f.Close()
+		content, _ := ioutil.ReadAll(f)
+		if got, want := string(content), "privacy"; got != want {
+			t.Errorf("Upload content = %v, want %v", got, want)
+		}
+		fmt.Fprint(w, `{
+							  "errcode": 0,
+							  "errmsg": "ok",
+							  "ext_file_media_id": "xxxxx"
+							}`)
+	})
+	got, _, err := client.WXA.UploadPrivacyExtFile(context.Background(), "token", "privacy.txt", strings.NewReader("privacy"))
+	if err != nil {
+		t.Errorf("WXA.UploadPrivacyExtFile retured err: %v", err)
+	}
+	want := &PrivacyExtFile{ExtFileMediaID: "xxxxx"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("WXA.UploadPrivacyExtFile got %+v, want %+v", got, want)
+	}
+}
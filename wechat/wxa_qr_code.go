@@ -2,8 +2,11 @@ package wechat
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 )
 
 // CreateQRCodeRequest represents request of create qr code.
@@ -34,19 +37,58 @@ type LineColor struct {
 	B int `json:"b"`
 }
 
+// ParseLineColor parses a hex color such as "#1AAD19", the "#" is optional.
+func ParseLineColor(s string) (*LineColor, error) {
+	hex := strings.TrimPrefix(s, "#")
+	if len(hex) != 6 {
+		return nil, fmt.Errorf("wechat: invalid line color %q", s)
+	}
+	rgb, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return nil, fmt.Errorf("wechat: invalid line color %q", s)
+	}
+	return &LineColor{R: int(rgb >> 16 & 0xff), G: int(rgb >> 8 & 0xff), B: int(rgb & 0xff)}, nil
+}
+
+// Bounds of the width of WXA codes, 0 means the default 430.
+const (
+	minWXACodeWidth = 280
+	maxWXACodeWidth = 1280
+)
+
+func validateWXACode(path string, width int) error {
+	if strings.HasPrefix(path, "/") {
+		return errors.New("wechat: path must not start with /")
+	}
+	if width != 0 && (width < minWXACodeWidth || width > maxWXACodeWidth) {
+		return fmt.Errorf("wechat: width must be between %d and %d", minWXACodeWidth, maxWXACodeWidth)
+	}
+	return nil
+}
+
 // GetWXACodeRequest represents request of get qr code.
 type GetWXACodeRequest struct {
-	Path      string     `json:"path"`
-	Width     int        `json:"width,omitempty"`
-	AutoColor bool       `json:"auto_color,omitempty"`
-	LineColor *LineColor `json:"line_color,omitempty"`
-	IsHyaline bool       `json:"is_hyaline,omitempty"`
+	Path       string     `json:"path"`
+	Width      int        `json:"width,omitempty"`
+	AutoColor  bool       `json:"auto_color,omitempty"`
+	LineColor  *LineColor `json:"line_color,omitempty"`
+	IsHyaline  bool       `json:"is_hyaline,omitempty"`
+	EnvVersion EnvVersion `json:"env_version,omitempty"`
+	CheckPath  *bool      `json:"check_path,omitempty"`
+}
+
+// Validate checks the path and width of r.
+func (r *GetWXACodeRequest) Validate() error {
+	return validateWXACode(r.Path, r.Width)
 }
 
 // GetWXACode
 // Wechat API docs:
 // https://developers.weixin.qq.com/miniprogram/dev/api-backend/open-api/qr-code/wxacode.get.html
 func (s *WXAService) GetWXACode(ctx context.Context, token string, r *GetWXACodeRequest) (*Response, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
 	u := fmt.Sprintf("wxa/getwxacode?access_token=%v", token)
 	req, err := s.client.NewRequest(http.MethodPost, u, r)
 	if err != nil {
@@ -60,18 +102,31 @@ func (s *WXAService) GetWXACode(ctx context.Context, token string, r *GetWXACode
 
 // GetWXACodeUnlimitRequest represents request of get qr code.
 type GetWXACodeUnlimitRequest struct {
-	Scene     string     `json:"scene"`
-	Path      string     `json:"path,omitempty"`
-	Width     int        `json:"width,omitempty"`
-	AutoColor bool       `json:"auto_color,omitempty"`
-	LineColor *LineColor `json:"line_color,omitempty"`
-	IsHyaline bool       `json:"is_hyaline,omitempty"`
+	Scene      string     `json:"scene"`
+	Path       string     `json:"path,omitempty"`
+	Width      int        `json:"width,omitempty"`
+	AutoColor  bool       `json:"auto_color,omitempty"`
+	LineColor  *LineColor `json:"line_color,omitempty"`
+	IsHyaline  bool       `json:"is_hyaline,omitempty"`
+	EnvVersion EnvVersion `json:"env_version,omitempty"`
+	CheckPath  *bool      `json:"check_path,omitempty"`
+}
+
+// Validate checks the scene, path and width of r.
+func (r *GetWXACodeUnlimitRequest) Validate() error {
+	if err := ValidateScene(r.Scene); err != nil {
+		return err
+	}
+	return validateWXACode(r.Path, r.Width)
 }
 
 // GetWXACodeUnlimit
 // Wechat API docs:
 // https://developers.weixin.qq.com/miniprogram/dev/api-backend/open-api/qr-code/wxacode.getUnlimited.html
 func (s *WXAService) GetWXACodeUnlimit(ctx context.Context, token string, r *GetWXACodeUnlimitRequest) (*Response, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
 	u := fmt.Sprintf("wxa/getwxacodeunlimit?access_token=%v", token)
 	req, err := s.client.NewRequest(http.MethodPost, u, r)
 	if err != nil {
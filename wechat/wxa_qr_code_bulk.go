@@ -0,0 +1,350 @@
+package wechat
+
+import (
+	"archive/zip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// ErrBlobNotFound is returned by a BlobStore when a key is unknown.
+var ErrBlobNotFound = errors.New("wechat: blob not found")
+
+// BlobStore stores blobs by key. Implementations must be safe for
+// concurrent use.
+type BlobStore interface {
+	// Get returns the blob of key, or ErrBlobNotFound.
+	Get(ctx context.Context, key string) ([]byte, error)
+	// Put stores the blob of key.
+	Put(ctx context.Context, key string, data []byte) error
+}
+
+// FileBlobStore is a BlobStore on the filesystem. Blobs are stored under Dir,
+// sharded by the first two characters of their key.
+type FileBlobStore struct {
+	Dir string
+}
+
+func (s *FileBlobStore) path(key string) string {
+	if len(key) < 2 {
+		return filepath.Join(s.Dir, key)
+	}
+	return filepath.Join(s.Dir, key[:2], key)
+}
+
+// Get implements BlobStore.
+func (s *FileBlobStore) Get(ctx context.Context, key string) ([]byte, error) {
+	data, err := ioutil.ReadFile(s.path(key))
+	if os.IsNotExist(err) {
+		return nil, ErrBlobNotFound
+	}
+	return data, err
+}
+
+// Put implements BlobStore. The blob is written to a temporary file first so
+// an interrupted Put never leaves a partial blob.
+func (s *FileBlobStore) Put(ctx context.Context, key string, data []byte) error {
+	path := s.path(key)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	f, err := ioutil.TempFile(filepath.Dir(path), key+".tmp")
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+// BulkCodeWriter receives the files written by BulkCodeGenerator.
+type BulkCodeWriter interface {
+	Create(name string, data []byte) error
+}
+
+// DirCodeWriter is a BulkCodeWriter writing files into Dir.
+type DirCodeWriter struct {
+	Dir string
+}
+
+// Create implements BulkCodeWriter.
+func (w *DirCodeWriter) Create(name string, data []byte) error {
+	if err := os.MkdirAll(w.Dir, 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(w.Dir, name), data, 0644)
+}
+
+// ZipCodeWriter is a BulkCodeWriter writing files into a zip archive. Close
+// must be called to complete the archive.
+type ZipCodeWriter struct {
+	zw *zip.Writer
+}
+
+// NewZipCodeWriter returns a ZipCodeWriter writing the archive to w.
+func NewZipCodeWriter(w io.Writer) *ZipCodeWriter {
+	return &ZipCodeWriter{zw: zip.NewWriter(w)}
+}
+
+// Create implements BulkCodeWriter.
+func (w *ZipCodeWriter) Create(name string, data []byte) error {
+	f, err := w.zw.Create(name)
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(data)
+	return err
+}
+
+// Close completes the archive, it does not close the underlying writer.
+func (w *ZipCodeWriter) Close() error {
+	return w.zw.Close()
+}
+
+// BulkCodeItem represents a code to generate in bulk.
+type BulkCodeItem struct {
+	// Name identifies the item in the manifest, the hash of Request if empty.
+	Name    string
+	Request *GetWXACodeUnlimitRequest
+}
+
+// BulkCodeEntry represents an item in the manifest.
+type BulkCodeEntry struct {
+	Name   string `json:"name"`
+	Hash   string `json:"hash,omitempty"`
+	File   string `json:"file,omitempty"`
+	Cached bool   `json:"cached,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+// BulkCodeManifest lists the items generated by BulkCodeGenerator, in the
+// order they were received.
+type BulkCodeManifest struct {
+	Entries []*BulkCodeEntry `json:"entries"`
+}
+
+// BulkCodeManifestName is the name of the manifest written by
+// BulkCodeGenerator.
+const BulkCodeManifestName = "manifest.json"
+
+// defaultBulkConcurrency is the default concurrency of BulkCodeGenerator.
+const defaultBulkConcurrency = 4
+
+// BulkCodeGenerator generates codes with GetWXACodeUnlimit in bulk.
+//
+// Requests are deduplicated by the SHA-256 of their parameters: identical
+// requests are generated and written once, as <hash>.jpg or <hash>.png.
+// Images are cached in Store under their hash, so running the generator
+// again after a failure only generates the missing codes. A manifest is
+// written last as BulkCodeManifestName.
+type BulkCodeGenerator struct {
+	WXA   *WXAService
+	Token string
+	// Store caches the images. If nil, nothing is cached.
+	Store BlobStore
+	// Concurrency bounds the codes processed at the same time, defaults to
+	// 4. Requests to Wechat are serialized by the client, concurrency
+	// overlaps them with the store and writer.
+	Concurrency int
+}
+
+type bulkCodeJob struct {
+	hash   string
+	req    *GetWXACodeUnlimitRequest
+	file   string
+	cached bool
+	err    error
+}
+
+type bulkCodeRef struct {
+	name string
+	job  *bulkCodeJob
+}
+
+// Generate generates the codes of items until items is closed or ctx is
+// done, writes them and the manifest to w and returns the manifest. It
+// returns an error if any item failed, the manifest tells which.
+func (g *BulkCodeGenerator) Generate(ctx context.Context, items <-chan *BulkCodeItem, w BulkCodeWriter) (*BulkCodeManifest, error) {
+	n := g.Concurrency
+	if n <= 0 {
+		n = defaultBulkConcurrency
+	}
+	var wmu sync.Mutex
+	work := make(chan *bulkCodeJob)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range work {
+				g.process(ctx, job, w, &wmu)
+			}
+		}()
+	}
+
+	jobs := make(map[string]*bulkCodeJob)
+	var refs []*bulkCodeRef
+loop:
+	for {
+		var item *BulkCodeItem
+		select {
+		case it, ok := <-items:
+			if !ok {
+				break loop
+			}
+			item = it
+		case <-ctx.Done():
+			break loop
+		}
+		if item == nil {
+			refs = append(refs, &bulkCodeRef{job: &bulkCodeJob{err: errors.New("wechat: nil item")}})
+			continue
+		}
+		hash, err := hashCodeRequest(item.Request)
+		if err != nil {
+			refs = append(refs, &bulkCodeRef{name: item.Name, job: &bulkCodeJob{err: err}})
+			continue
+		}
+		job, ok := jobs[hash]
+		if !ok {
+			job = &bulkCodeJob{hash: hash, req: item.Request}
+			jobs[hash] = job
+			select {
+			case work <- job:
+			case <-ctx.Done():
+				job.err = ctx.Err()
+				refs = append(refs, &bulkCodeRef{name: item.Name, job: job})
+				break loop
+			}
+		}
+		refs = append(refs, &bulkCodeRef{name: item.Name, job: job})
+	}
+	close(work)
+	wg.Wait()
+
+	manifest := &BulkCodeManifest{Entries: make([]*BulkCodeEntry, len(refs))}
+	failed := 0
+	for i, ref := range refs {
+		entry := &BulkCodeEntry{Name: ref.name, Hash: ref.job.hash, File: ref.job.file, Cached: ref.job.cached}
+		if entry.Name == "" {
+			entry.Name = ref.job.hash
+		}
+		if ref.job.err != nil {
+			entry.Error = ref.job.err.Error()
+			failed++
+		}
+		manifest.Entries[i] = entry
+	}
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return manifest, err
+	}
+	if err := w.Create(BulkCodeManifestName, data); err != nil {
+		return manifest, err
+	}
+	if err := ctx.Err(); err != nil {
+		return manifest, err
+	}
+	if failed > 0 {
+		return manifest, fmt.Errorf("wechat: %d of %d codes failed", failed, len(refs))
+	}
+	return manifest, nil
+}
+
+// process fetches the image of job from the store or Wechat and writes it.
+func (g *BulkCodeGenerator) process(ctx context.Context, job *bulkCodeJob, w BulkCodeWriter, wmu *sync.Mutex) {
+	if job.err = ctx.Err(); job.err != nil {
+		return
+	}
+	var data []byte
+	err := ErrBlobNotFound
+	if g.Store != nil {
+		data, err = g.Store.Get(ctx, job.hash)
+	}
+	switch {
+	case err == nil:
+		job.cached = true
+	case errors.Is(err, ErrBlobNotFound):
+		if data, err = g.fetch(ctx, job.req); err != nil {
+			job.err = err
+			return
+		}
+		if g.Store != nil {
+			if err := g.Store.Put(ctx, job.hash, data); err != nil {
+				job.err = err
+				return
+			}
+		}
+	default:
+		job.err = err
+		return
+	}
+	file := job.hash + imageExt(data)
+	wmu.Lock()
+	defer wmu.Unlock()
+	if job.err = w.Create(file, data); job.err == nil {
+		job.file = file
+	}
+}
+
+// fetch fetches the image of r from Wechat. Responses which are not a PNG or
+// JPEG image, like an error page of a gateway, are rejected so that they are
+// neither stored nor written.
+func (g *BulkCodeGenerator) fetch(ctx context.Context, r *GetWXACodeUnlimitRequest) ([]byte, error) {
+	resp, err := g.WXA.GetWXACodeUnlimit(ctx, g.Token, r)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("wechat: code request returned status %d", resp.StatusCode)
+	}
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if ct := http.DetectContentType(data); ct != "image/png" && ct != "image/jpeg" {
+		return nil, fmt.Errorf("wechat: code response is %s, not an image", ct)
+	}
+	return data, nil
+}
+
+// hashCodeRequest validates r and returns the hex SHA-256 of its parameters.
+func hashCodeRequest(r *GetWXACodeUnlimitRequest) (string, error) {
+	if r == nil {
+		return "", errors.New("wechat: nil request")
+	}
+	if err := r.Validate(); err != nil {
+		return "", err
+	}
+	data, err := json.Marshal(r)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// imageExt returns the file extension of an image returned by Wechat.
+func imageExt(data []byte) string {
+	if http.DetectContentType(data) == "image/png" {
+		return ".png"
+	}
+	return ".jpg"
+}
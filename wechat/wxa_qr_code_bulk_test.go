@@ -0,0 +1,239 @@
+package wechat
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+)
+
+const testPNGHeader = "\x89PNG\r\n\x1a\n"
+
+// bulkCodeItems returns a closed channel of items.
+func bulkCodeItems(items ...*BulkCodeItem) <-chan *BulkCodeItem {
+	ch := make(chan *BulkCodeItem, len(items))
+	for _, item := range items {
+		ch <- item
+	}
+	close(ch)
+	return ch
+}
+
+// handleBulkCodes serves codes, failing for the scenes in fail, and counts
+// the requests by scene.
+func handleBulkCodes(mux *http.ServeMux, fail map[string]bool) map[string]int {
+	var mu sync.Mutex
+	requests := make(map[string]int)
+	mux.HandleFunc("/wxa/getwxacodeunlimit", func(w http.ResponseWriter, r *http.Request) {
+		var req GetWXACodeUnlimitRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		mu.Lock()
+		requests[req.Scene]++
+		mu.Unlock()
+		if fail[req.Scene] {
+			fmt.Fprint(w, `{"errcode": 45009, "errmsg": "reach max api daily quota limit"}`)
+			return
+		}
+		w.Header().Set("Content-Type", "image/png")
+		fmt.Fprint(w, testPNGHeader+req.Scene)
+	})
+	return requests
+}
+
+func TestFileBlobStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "wechat")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	s := &FileBlobStore{Dir: dir}
+	if _, err := s.Get(context.Background(), "abcd"); err != ErrBlobNotFound {
+		t.Errorf("FileBlobStore.Get err = %v, want %v", err, ErrBlobNotFound)
+	}
+	if err := s.Put(context.Background(), "abcd", []byte("data")); err != nil {
+		t.Fatalf("FileBlobStore.Put returned err: %v", err)
+	}
+	got, err := s.Get(context.Background(), "abcd")
+	if err != nil || string(got) != "data" {
+		t.Errorf("FileBlobStore.Get = %q, %v, want %q", got, err, "data")
+	}
+	if _, err := os.Stat(filepath.Join(dir, "ab", "abcd")); err != nil {
+		t.Errorf("FileBlobStore did not shard the blob: %v", err)
+	}
+}
+
+func TestBulkCodeGenerator_Generate(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+	requests := handleBulkCodes(mux, nil)
+
+	dir, err := ioutil.TempDir("", "wechat")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	g := &BulkCodeGenerator{WXA: client.WXA, Token: "token", Store: &FileBlobStore{Dir: filepath.Join(dir, "cache")}}
+	items := []*BulkCodeItem{
+		{Name: "a", Request: &GetWXACodeUnlimitRequest{Scene: "id=1"}},
+		{Name: "b", Request: &GetWXACodeUnlimitRequest{Scene: "id=2"}},
+		{Name: "c", Request: &GetWXACodeUnlimitRequest{Scene: "id=1"}},
+	}
+	out := &DirCodeWriter{Dir: filepath.Join(dir, "out")}
+	manifest, err := g.Generate(context.Background(), bulkCodeItems(items...), out)
+	if err != nil {
+		t.Fatalf("BulkCodeGenerator.Generate returned err: %v", err)
+	}
+	if requests["id=1"] != 1 || requests["id=2"] != 1 {
+		t.Errorf("BulkCodeGenerator requests = %v, want one per scene", requests)
+	}
+	if len(manifest.Entries) != 3 {
+		t.Fatalf("BulkCodeGenerator manifest has %d entries, want 3", len(manifest.Entries))
+	}
+	a, c := manifest.Entries[0], manifest.Entries[2]
+	if a.Name != "a" || a.File == "" || a.File != c.File || filepath.Ext(a.File) != ".png" {
+		t.Errorf("BulkCodeGenerator manifest entries = %+v, %+v", a, c)
+	}
+	data, err := ioutil.ReadFile(filepath.Join(dir, "out", a.File))
+	if err != nil || string(data) != testPNGHeader+"id=1" {
+		t.Errorf("BulkCodeGenerator wrote %q, %v", data, err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "out", BulkCodeManifestName)); err != nil {
+		t.Errorf("BulkCodeGenerator did not write the manifest: %v", err)
+	}
+
+	manifest, err = g.Generate(context.Background(), bulkCodeItems(items...), out)
+	if err != nil {
+		t.Fatalf("BulkCodeGenerator.Generate returned err: %v", err)
+	}
+	if requests["id=1"] != 1 || requests["id=2"] != 1 {
+		t.Errorf("BulkCodeGenerator requests = %v after a cached run", requests)
+	}
+	for _, e := range manifest.Entries {
+		if !e.Cached {
+			t.Errorf("BulkCodeGenerator entry %+v is not cached", e)
+		}
+	}
+}
+
+func TestBulkCodeGenerator_resume(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+	fail := map[string]bool{"id=2": true}
+	requests := handleBulkCodes(mux, fail)
+
+	dir, err := ioutil.TempDir("", "wechat")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	g := &BulkCodeGenerator{WXA: client.WXA, Token: "token", Store: &FileBlobStore{Dir: dir}, Concurrency: 1}
+	items := []*BulkCodeItem{
+		{Name: "a", Request: &GetWXACodeUnlimitRequest{Scene: "id=1"}},
+		{Name: "b", Request: &GetWXACodeUnlimitRequest{Scene: "id=2"}},
+		{Name: "invalid", Request: &GetWXACodeUnlimitRequest{Scene: "id=3", Width: 100}},
+		nil,
+	}
+	var buf bytes.Buffer
+	zw := NewZipCodeWriter(&buf)
+	manifest, err := g.Generate(context.Background(), bulkCodeItems(items...), zw)
+	if err == nil {
+		t.Error("BulkCodeGenerator.Generate returned nil err")
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if manifest.Entries[0].Error != "" || manifest.Entries[1].Error == "" || manifest.Entries[2].Error == "" || manifest.Entries[3].Error == "" {
+		t.Errorf("BulkCodeGenerator manifest entries = %+v, %+v, %+v, %+v", manifest.Entries[0], manifest.Entries[1], manifest.Entries[2], manifest.Entries[3])
+	}
+	if requests["id=3"] != 0 {
+		t.Error("BulkCodeGenerator sent an invalid request")
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	names := make(map[string]bool)
+	for _, f := range zr.File {
+		names[f.Name] = true
+	}
+	if len(names) != 2 || !names[BulkCodeManifestName] || !names[manifest.Entries[0].File] {
+		t.Errorf("BulkCodeGenerator zip files = %v", names)
+	}
+
+	delete(fail, "id=2")
+	manifest, err = g.Generate(context.Background(), bulkCodeItems(items[:2]...), NewZipCodeWriter(ioutil.Discard))
+	if err != nil {
+		t.Fatalf("BulkCodeGenerator.Generate returned err: %v", err)
+	}
+	if requests["id=1"] != 1 || requests["id=2"] != 2 {
+		t.Errorf("BulkCodeGenerator requests = %v, want only the failed code again", requests)
+	}
+	if !manifest.Entries[0].Cached || manifest.Entries[1].Cached {
+		t.Errorf("BulkCodeGenerator manifest entries = %+v, %+v", manifest.Entries[0], manifest.Entries[1])
+	}
+}
+
+func TestBulkCodeGenerator_badGateway(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+	mux.HandleFunc("/wxa/getwxacodeunlimit", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+		fmt.Fprint(w, "<html>Bad Gateway</html>")
+	})
+
+	dir, err := ioutil.TempDir("", "wechat")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	g := &BulkCodeGenerator{WXA: client.WXA, Token: "token", Store: &FileBlobStore{Dir: dir}}
+	item := &BulkCodeItem{Name: "a", Request: &GetWXACodeUnlimitRequest{Scene: "id=1"}}
+	var buf bytes.Buffer
+	zw := NewZipCodeWriter(&buf)
+	manifest, err := g.Generate(context.Background(), bulkCodeItems(item), zw)
+	if err == nil {
+		t.Error("BulkCodeGenerator.Generate returned nil err")
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if e := manifest.Entries[0]; e.Error == "" || e.File != "" {
+		t.Errorf("BulkCodeGenerator manifest entry = %+v, want an error and no file", e)
+	}
+	if files, _ := ioutil.ReadDir(dir); len(files) != 0 {
+		t.Errorf("BulkCodeGenerator stored %d blobs, want 0", len(files))
+	}
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(zr.File) != 1 || zr.File[0].Name != BulkCodeManifestName {
+		t.Errorf("BulkCodeGenerator zip has %d files, want only the manifest", len(zr.File))
+	}
+}
+
+func TestBulkCodeGenerator_canceled(t *testing.T) {
+	client, _, _, tearDown := setup()
+	defer tearDown()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	g := &BulkCodeGenerator{WXA: client.WXA, Token: "token"}
+	items := make(chan *BulkCodeItem)
+	_, err := g.Generate(ctx, items, NewZipCodeWriter(ioutil.Discard))
+	if err != context.Canceled {
+		t.Errorf("BulkCodeGenerator.Generate err = %v, want %v", err, context.Canceled)
+	}
+}
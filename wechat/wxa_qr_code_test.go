@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -124,3 +126,79 @@ func TestWXAService_GetQrCode_Stream(t *testing.T) {
 		t.Errorf("WXA.GetQrCode returned %+v, want %+v", content, want)
 	}
 }
+
+func TestWXAService_GetWXACodeUnlimit_envVersion(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/wxa/getwxacodeunlimit", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"scene":"a=1","path":"pages/index/index","width":430,"env_version":"trial","check_path":false}`+"\n")
+		fmt.Fprint(w, "Hello World")
+	})
+
+	checkPath := false
+	_, err := client.WXA.GetWXACodeUnlimit(context.Background(), "o", &GetWXACodeUnlimitRequest{
+		Scene:      "a=1",
+		Path:       "pages/index/index",
+		Width:      430,
+		EnvVersion: EnvVersionTrial,
+		CheckPath:  &checkPath,
+	})
+	if err != nil {
+		t.Errorf("WXA.GetWXACodeUnlimit returned error: %v", err)
+	}
+}
+
+func TestGetWXACodeUnlimitRequest_Validate(t *testing.T) {
+	tests := []struct {
+		r  *GetWXACodeUnlimitRequest
+		ok bool
+	}{
+		{&GetWXACodeUnlimitRequest{}, true},
+		{&GetWXACodeUnlimitRequest{Scene: "id=1", Path: "pages/index/index", Width: 280}, true},
+		{&GetWXACodeUnlimitRequest{Width: 1280}, true},
+		{&GetWXACodeUnlimitRequest{Width: 279}, false},
+		{&GetWXACodeUnlimitRequest{Width: 1281}, false},
+		{&GetWXACodeUnlimitRequest{Path: "/pages/index/index"}, false},
+		{&GetWXACodeUnlimitRequest{Scene: "id 1"}, false},
+		{&GetWXACodeUnlimitRequest{Scene: strings.Repeat("a", 33)}, false},
+	}
+	for _, tt := range tests {
+		if err := tt.r.Validate(); (err == nil) != tt.ok {
+			t.Errorf("Validate(%+v) = %v, want ok %v", tt.r, err, tt.ok)
+		}
+	}
+}
+
+func TestWXAService_GetWXACode_invalid(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	_, err := client.WXA.GetWXACode(context.Background(), "o", &GetWXACodeRequest{Path: "/pages/index/index"})
+	if err == nil {
+		t.Error("WXA.GetWXACode returned nil err for a path with a leading slash")
+	}
+	_, err = client.WXA.GetWXACode(context.Background(), "o", &GetWXACodeRequest{Width: 100})
+	if err == nil {
+		t.Error("WXA.GetWXACode returned nil err for a width of 100")
+	}
+}
+
+func TestParseLineColor(t *testing.T) {
+	got, err := ParseLineColor("#1AAD19")
+	if err != nil {
+		t.Fatalf("ParseLineColor returned err: %v", err)
+	}
+	if want := (&LineColor{R: 26, G: 173, B: 25}); !reflect.DeepEqual(want, got) {
+		t.Errorf("ParseLineColor got %+v, want %+v", got, want)
+	}
+	if got, _ := ParseLineColor("ffffff"); !reflect.DeepEqual(got, &LineColor{R: 255, G: 255, B: 255}) {
+		t.Errorf("ParseLineColor(\"ffffff\") got %+v", got)
+	}
+	for _, s := range []string{"", "#fff", "#1AAD1G", "#1AAD190"} {
+		if _, err := ParseLineColor(s); err == nil {
+			t.Errorf("ParseLineColor(%q) returned nil err", s)
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package wechat
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// QRCodeJumpOpenVersion represents the version of the mini program a QR code
+// jump rule opens.
+type QRCodeJumpOpenVersion string
+
+// QR code jump open versions.
+const (
+	QRCodeJumpOpenVersionDevelop QRCodeJumpOpenVersion = "1" // 开发版
+	QRCodeJumpOpenVersionTrial   QRCodeJumpOpenVersion = "2" // 体验版
+	QRCodeJumpOpenVersionRelease QRCodeJumpOpenVersion = "3" // 正式版
+)
+
+// QRCodeJumpPermitSubRule represents whether a QR code jump rule reserves
+// the sub rules of its prefix.
+type QRCodeJumpPermitSubRule string
+
+// QR code jump permit sub rules.
+const (
+	QRCodeJumpPermitSubRuleAllowed  QRCodeJumpPermitSubRule = "1" // 不占用, other accounts may add sub rules
+	QRCodeJumpPermitSubRuleReserved QRCodeJumpPermitSubRule = "2" // 占用
+)
+
+// QRCodeJumpState represents the state of a QR code jump rule.
+type QRCodeJumpState int
+
+// QR code jump states.
+const (
+	QRCodeJumpStateUnpublished QRCodeJumpState = 1 // 未发布
+	QRCodeJumpStatePublished   QRCodeJumpState = 2 // 已发布
+)
+
+// QRCodeJumpRule represents a rule opening the mini program from QR codes of
+// ordinary links.
+type QRCodeJumpRule struct {
+	Prefix        string                  `json:"prefix"`
+	PermitSubRule QRCodeJumpPermitSubRule `json:"permit_sub_rule"`
+	Path          string                  `json:"path"`
+	OpenVersion   QRCodeJumpOpenVersion   `json:"open_version"`
+	DebugURL      []string                `json:"debug_url,omitempty"`
+	State         QRCodeJumpState         `json:"state,omitempty"`
+}
+
+// QRCodeJumpRules represents get qrcode jump response.
+type QRCodeJumpRules struct {
+	RuleList           []*QRCodeJumpRule `json:"rule_list"`
+	QRCodeJumpOpen     int               `json:"qrcodejump_open"`
+	ListSize           int               `json:"list_size"`
+	QRCodeJumpPubQuota int               `json:"qrcodejump_pub_quota"`
+}
+
+// GetQRCodeJump fetch the QR code jump rules.
+// Wechat API docs:
+// https://developers.weixin.qq.com/doc/oplatform/Third-party_Platforms/Mini_Programs/qrcode/qrcodejumpget.html
+func (s *WXAService) GetQRCodeJump(ctx context.Context, token string) (*QRCodeJumpRules, *Response, error) {
+	u := fmt.Sprintf("cgi-bin/wxopen/qrcodejumpget?access_token=%v", token)
+	req, err := s.client.NewRequest(http.MethodPost, u, struct{}{})
+	if err != nil {
+		return nil, nil, err
+	}
+	rules := new(QRCodeJumpRules)
+	resp, err := s.client.Do(ctx, req, rules)
+	if err != nil {
+		return nil, resp, err
+	}
+	return rules, resp, nil
+}
+
+// QRCodeJumpFile represents the verification file of QR code jump rules.
+type QRCodeJumpFile struct {
+	FileName    string `json:"file_name"`
+	FileContent string `json:"file_content"`
+}
+
+// DownloadQRCodeJumpFile fetch the verification file to serve under the
+// prefix of QR code jump rules.
+// Wechat API docs:
+// https://developers.weixin.qq.com/doc/oplatform/Third-party_Platforms/Mini_Programs/qrcode/qrcodejumpdownload.html
+func (s *WXAService) DownloadQRCodeJumpFile(ctx context.Context, token string) (*QRCodeJumpFile, *Response, error) {
+	u := fmt.Sprintf("cgi-bin/wxopen/qrcodejumpdownload?access_token=%v", token)
+	req, err := s.client.NewRequest(http.MethodPost, u, struct{}{})
+	if err != nil {
+		return nil, nil, err
+	}
+	file := new(QRCodeJumpFile)
+	resp, err := s.client.Do(ctx, req, file)
+	if err != nil {
+		return nil, resp, err
+	}
+	return file, resp, nil
+}
+
+// AddQRCodeJump add a QR code jump rule, or edit the rule of the same prefix
+// if isEdit.
+// Wechat API docs:
+// https://developers.weixin.qq.com/doc/oplatform/Third-party_Platforms/Mini_Programs/qrcode/qrcodejumpadd.html
+func (s *WXAService) AddQRCodeJump(ctx context.Context, token string, rule *QRCodeJumpRule, isEdit bool) (*Response, error) {
+	u := fmt.Sprintf("cgi-bin/wxopen/qrcodejumpadd?access_token=%v", token)
+	payload := struct {
+		Prefix        string                  `json:"prefix"`
+		PermitSubRule QRCodeJumpPermitSubRule `json:"permit_sub_rule"`
+		Path          string                  `json:"path"`
+		OpenVersion   QRCodeJumpOpenVersion   `json:"open_version"`
+		DebugURL      []string                `json:"debug_url,omitempty"`
+		IsEdit        int                     `json:"is_edit"`
+	}{
+		Prefix:        rule.Prefix,
+		PermitSubRule: rule.PermitSubRule,
+		Path:          rule.Path,
+		OpenVersion:   rule.OpenVersion,
+		DebugURL:      rule.DebugURL,
+	}
+	if isEdit {
+		payload.IsEdit = 1
+	}
+	req, err := s.client.NewRequest(http.MethodPost, u, payload)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+// PublishQRCodeJump publish the QR code jump rule of prefix.
+// Wechat API docs:
+// https://developers.weixin.qq.com/doc/oplatform/Third-party_Platforms/Mini_Programs/qrcode/qrcodejumppublish.html
+func (s *WXAService) PublishQRCodeJump(ctx context.Context, token, prefix string) (*Response, error) {
+	u := fmt.Sprintf("cgi-bin/wxopen/qrcodejumppublish?access_token=%v", token)
+	payload := struct {
+		Prefix string `json:"prefix"`
+	}{Prefix: prefix}
+	req, err := s.client.NewRequest(http.MethodPost, u, payload)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+// DeleteQRCodeJump delete the QR code jump rule of prefix.
+// Wechat API docs:
+// https://developers.weixin.qq.com/doc/oplatform/Third-party_Platforms/Mini_Programs/qrcode/qrcodejumpdelete.html
+func (s *WXAService) DeleteQRCodeJump(ctx context.Context, token, prefix string) (*Response, error) {
+	u := fmt.Sprintf("cgi-bin/wxopen/qrcodejumpdelete?access_token=%v", token)
+	payload := struct {
+		Prefix string `json:"prefix"`
+	}{Prefix: prefix}
+	req, err := s.client.NewRequest(http.MethodPost, u, payload)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,126 @@
+package wechat
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestWXAService_GetQRCodeJump(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/cgi-bin/wxopen/qrcodejumpget", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{}`+"\n")
+		fmt.Fprint(w, `{
+							  "errcode": 0,
+							  "errmsg": "ok",
+							  "rule_list": [
+								{
+								  "prefix": "https://weixin.qq.com/qrcodejump",
+								  "permit_sub_rule": "1",
+								  "path": "pages/index/index",
+								  "open_version": "1",
+								  "debug_url": ["https://weixin.qq.com/qrcodejump?a=1"],
+								  "state": 2
+								}
+							  ],
+							  "qrcodejump_open": 1,
+							  "list_size": 1,
+							  "qrcodejump_pub_quota": 1000
+							}`)
+	})
+	got, _, err := client.WXA.GetQRCodeJump(context.Background(), "token")
+	if err != nil {
+		t.Errorf("WXA.GetQRCodeJump retured err: %v", err)
+	}
+	want := &QRCodeJumpRules{
+		RuleList: []*QRCodeJumpRule{{
+			Prefix:        "https://weixin.qq.com/qrcodejump",
+			PermitSubRule: QRCodeJumpPermitSubRuleAllowed,
+			Path:          "pages/index/index",
+			OpenVersion:   QRCodeJumpOpenVersionDevelop,
+			DebugURL:      []string{"https://weixin.qq.com/qrcodejump?a=1"},
+			State:         QRCodeJumpStatePublished,
+		}},
+		QRCodeJumpOpen:     1,
+		ListSize:           1,
+		QRCodeJumpPubQuota: 1000,
+	}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("WXA.GetQRCodeJump got %+v, want %+v", got, want)
+	}
+}
+
+func TestWXAService_DownloadQRCodeJumpFile(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/cgi-bin/wxopen/qrcodejumpdownload", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprint(w, `{"errcode": 0, "errmsg": "ok", "file_name": "xTfHbdxkLM.txt", "file_content": "4c1f2f7a3b2e"}`)
+	})
+	got, _, err := client.WXA.DownloadQRCodeJumpFile(context.Background(), "token")
+	if err != nil {
+		t.Errorf("WXA.DownloadQRCodeJumpFile retured err: %v", err)
+	}
+	want := &QRCodeJumpFile{FileName: "xTfHbdxkLM.txt", FileContent: "4c1f2f7a3b2e"}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("WXA.DownloadQRCodeJumpFile got %+v, want %+v", got, want)
+	}
+}
+
+func TestWXAService_AddQRCodeJump(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/cgi-bin/wxopen/qrcodejumpadd", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"prefix":"https://weixin.qq.com/qrcodejump","permit_sub_rule":"2","path":"pages/index/index","open_version":"3","debug_url":["https://weixin.qq.com/qrcodejump?a=1"],"is_edit":1}`+"\n")
+		fmt.Fprint(w, `{"errcode": 0, "errmsg": "ok"}`)
+	})
+	_, err := client.WXA.AddQRCodeJump(context.Background(), "token", &QRCodeJumpRule{
+		Prefix:        "https://weixin.qq.com/qrcodejump",
+		PermitSubRule: QRCodeJumpPermitSubRuleReserved,
+		Path:          "pages/index/index",
+		OpenVersion:   QRCodeJumpOpenVersionRelease,
+		DebugURL:      []string{"https://weixin.qq.com/qrcodejump?a=1"},
+		State:         QRCodeJumpStatePublished,
+	}, true)
+	if err != nil {
+		t.Errorf("WXA.AddQRCodeJump retured err: %v", err)
+	}
+}
+
+func TestWXAService_PublishQRCodeJump(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/cgi-bin/wxopen/qrcodejumppublish", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"prefix":"https://weixin.qq.com/qrcodejump"}`+"\n")
+		fmt.Fprint(w, `{"errcode": 0, "errmsg": "ok"}`)
+	})
+	_, err := client.WXA.PublishQRCodeJump(context.Background(), "token", "https://weixin.qq.com/qrcodejump")
+	if err != nil {
+		t.Errorf("WXA.PublishQRCodeJump retured err: %v", err)
+	}
+}
+
+func TestWXAService_DeleteQRCodeJump(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/cgi-bin/wxopen/qrcodejumpdelete", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"prefix":"https://weixin.qq.com/qrcodejump"}`+"\n")
+		fmt.Fprint(w, `{"errcode": 0, "errmsg": "ok"}`)
+	})
+	_, err := client.WXA.DeleteQRCodeJump(context.Background(), "token", "https://weixin.qq.com/qrcodejump")
+	if err != nil {
+		t.Errorf("WXA.DeleteQRCodeJump retured err: %v", err)
+	}
+}
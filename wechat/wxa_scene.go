@@ -0,0 +1,216 @@
+package wechat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// MaxSceneLength is the maximum length of the scene of GetWXACodeUnlimit.
+const MaxSceneLength = 32
+
+// sceneStoreMarker prefixes scenes which are an id of a SceneStore rather
+// than packed params.
+const sceneStoreMarker = "$"
+
+// ErrSceneNotFound is returned when a stored scene id is unknown.
+var ErrSceneNotFound = errors.New("wechat: scene not found")
+
+// isSceneChar reports whether c is allowed in a scene, that is letters,
+// digits and !#$&'()*+,/:;=?@-._~.
+func isSceneChar(c byte) bool {
+	switch {
+	case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9':
+		return true
+	}
+	return strings.IndexByte("!#$&'()*+,/:;=?@-._~", c) >= 0
+}
+
+// ValidateScene checks that scene fits the length and character set allowed
+// by GetWXACodeUnlimit.
+func ValidateScene(scene string) error {
+	if len(scene) > MaxSceneLength {
+		return fmt.Errorf("wechat: scene is %d characters, longer than %d", len(scene), MaxSceneLength)
+	}
+	for i := 0; i < len(scene); i++ {
+		if !isSceneChar(scene[i]) {
+			return fmt.Errorf("wechat: scene contains invalid character %q", scene[i])
+		}
+	}
+	return nil
+}
+
+// SceneStore saves the params which cannot be packed in a scene, under a
+// short id.
+type SceneStore interface {
+	// Save stores params and returns their id. The id must only contain
+	// characters allowed in a scene and be at most MaxSceneLength-1 long.
+	Save(ctx context.Context, params map[string]string) (id string, err error)
+	// Load returns the params of id, or ErrSceneNotFound.
+	Load(ctx context.Context, id string) (map[string]string, error)
+}
+
+// MemorySceneStore is a SceneStore in memory. Saving the same params twice
+// returns the same id. It is safe for concurrent use.
+type MemorySceneStore struct {
+	mu     sync.Mutex
+	params map[string]map[string]string
+	ids    map[string]string
+}
+
+// NewMemorySceneStore returns an empty MemorySceneStore.
+func NewMemorySceneStore() *MemorySceneStore {
+	return &MemorySceneStore{
+		params: make(map[string]map[string]string),
+		ids:    make(map[string]string),
+	}
+}
+
+// Save implements SceneStore.
+func (s *MemorySceneStore) Save(ctx context.Context, params map[string]string) (string, error) {
+	values := make(url.Values, len(params))
+	for k, v := range params {
+		values.Set(k, v)
+	}
+	key := values.Encode()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if id, ok := s.ids[key]; ok {
+		return id, nil
+	}
+	id := strconv.FormatInt(int64(len(s.params)+1), 36)
+	s.ids[key] = id
+	s.params[id] = copyParams(params)
+	return id, nil
+}
+
+// Load implements SceneStore.
+func (s *MemorySceneStore) Load(ctx context.Context, id string) (map[string]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	params, ok := s.params[id]
+	if !ok {
+		return nil, ErrSceneNotFound
+	}
+	return copyParams(params), nil
+}
+
+// SceneCodec encodes params into the scene of GetWXACodeUnlimit and decodes
+// them back on the server side.
+//
+// Params are packed as k=v&k2=v2 when they fit in a scene, otherwise they
+// are saved to Store and the scene is the store id prefixed by "$".
+type SceneCodec struct {
+	// Store saves the params which cannot be packed. If nil, encoding such
+	// params fails.
+	Store SceneStore
+}
+
+// Encode returns the scene of params.
+func (c *SceneCodec) Encode(ctx context.Context, params map[string]string) (string, error) {
+	if scene, ok := packScene(params); ok {
+		return scene, nil
+	}
+	if c.Store == nil {
+		return "", errors.New("wechat: params do not fit in a scene and no scene store is set")
+	}
+	id, err := c.Store.Save(ctx, params)
+	if err != nil {
+		return "", err
+	}
+	scene := sceneStoreMarker + id
+	if err := ValidateScene(scene); err != nil {
+		return "", fmt.Errorf("wechat: invalid scene store id %q: %v", id, err)
+	}
+	return scene, nil
+}
+
+// Decode returns the params of scene. scene may be passed as received by the
+// mini program, that is still URL encoded.
+func (c *SceneCodec) Decode(ctx context.Context, scene string) (map[string]string, error) {
+	if strings.Contains(scene, "%") {
+		unescaped, err := url.QueryUnescape(scene)
+		if err != nil {
+			return nil, fmt.Errorf("wechat: invalid scene: %v", err)
+		}
+		scene = unescaped
+	}
+	if err := ValidateScene(scene); err != nil {
+		return nil, err
+	}
+	if strings.HasPrefix(scene, sceneStoreMarker) {
+		if c.Store == nil {
+			return nil, ErrSceneNotFound
+		}
+		return c.Store.Load(ctx, strings.TrimPrefix(scene, sceneStoreMarker))
+	}
+	params := make(map[string]string)
+	if scene == "" {
+		return params, nil
+	}
+	for _, pair := range strings.Split(scene, "&") {
+		kv := strings.SplitN(pair, "=", 2)
+		if len(kv) != 2 || kv[0] == "" {
+			return nil, fmt.Errorf("wechat: invalid scene param %q", pair)
+		}
+		params[kv[0]] = kv[1]
+	}
+	return params, nil
+}
+
+// packScene packs params as k=v&k2=v2 sorted by key. It reports false when
+// the result would not be a valid scene or could be mistaken for a store id.
+func packScene(params map[string]string) (string, bool) {
+	for k, v := range params {
+		if k == "" || !isPackable(k) || !isPackable(v) {
+			return "", false
+		}
+	}
+	scene := canonicalParams(params)
+	if strings.HasPrefix(scene, sceneStoreMarker) || len(scene) > MaxSceneLength {
+		return "", false
+	}
+	return scene, true
+}
+
+// isPackable reports whether s can be a key or value of a packed scene.
+func isPackable(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if !isSceneChar(s[i]) || s[i] == '&' || s[i] == '=' {
+			return false
+		}
+	}
+	return true
+}
+
+// canonicalParams returns params as k=v&k2=v2 sorted by key, without escaping.
+func canonicalParams(params map[string]string) string {
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var b strings.Builder
+	for i, k := range keys {
+		if i > 0 {
+			b.WriteByte('&')
+		}
+		b.WriteString(k)
+		b.WriteByte('=')
+		b.WriteString(params[k])
+	}
+	return b.String()
+}
+
+func copyParams(params map[string]string) map[string]string {
+	c := make(map[string]string, len(params))
+	for k, v := range params {
+		c[k] = v
+	}
+	return c
+}
@@ -0,0 +1,113 @@
+package wechat
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestValidateScene(t *testing.T) {
+	tests := []struct {
+		scene string
+		ok    bool
+	}{
+		{"", true},
+		{"a=1&b=2", true},
+		{"!#$&'()*+,/:;=?@-._~", true},
+		{strings.Repeat("a", 32), true},
+		{strings.Repeat("a", 33), false},
+		{"a b", false},
+		{"中文", false},
+		{"a%20b", false},
+	}
+	for _, tt := range tests {
+		if err := ValidateScene(tt.scene); (err == nil) != tt.ok {
+			t.Errorf("ValidateScene(%q) = %v, want ok %v", tt.scene, err, tt.ok)
+		}
+	}
+}
+
+func TestSceneCodec_packed(t *testing.T) {
+	c := &SceneCodec{}
+	params := map[string]string{"uid": "42", "c": "spring"}
+	scene, err := c.Encode(context.Background(), params)
+	if err != nil {
+		t.Fatalf("SceneCodec.Encode returned err: %v", err)
+	}
+	if want := "c=spring&uid=42"; scene != want {
+		t.Errorf("SceneCodec.Encode = %q, want %q", scene, want)
+	}
+	got, err := c.Decode(context.Background(), scene)
+	if err != nil {
+		t.Fatalf("SceneCodec.Decode returned err: %v", err)
+	}
+	if !reflect.DeepEqual(params, got) {
+		t.Errorf("SceneCodec.Decode got %v, want %v", got, params)
+	}
+}
+
+func TestSceneCodec_stored(t *testing.T) {
+	c := &SceneCodec{Store: NewMemorySceneStore()}
+	tests := []map[string]string{
+		{"campaign": "spring-sale-2020", "channel": "wechat-moments"},
+		{"name": "中文"},
+		{"q": "a&b=c"},
+		{"$a": "1"},
+	}
+	for _, params := range tests {
+		scene, err := c.Encode(context.Background(), params)
+		if err != nil {
+			t.Fatalf("SceneCodec.Encode(%v) returned err: %v", params, err)
+		}
+		if !strings.HasPrefix(scene, "$") {
+			t.Errorf("SceneCodec.Encode(%v) = %q, want a stored scene", params, scene)
+		}
+		if err := ValidateScene(scene); err != nil {
+			t.Errorf("SceneCodec.Encode(%v) = %q: %v", params, scene, err)
+		}
+		got, err := c.Decode(context.Background(), scene)
+		if err != nil {
+			t.Fatalf("SceneCodec.Decode(%q) returned err: %v", scene, err)
+		}
+		if !reflect.DeepEqual(params, got) {
+			t.Errorf("SceneCodec.Decode(%q) got %v, want %v", scene, got, params)
+		}
+	}
+
+	first, _ := c.Encode(context.Background(), tests[0])
+	again, _ := c.Encode(context.Background(), tests[0])
+	if first != again {
+		t.Errorf("SceneCodec.Encode returned %q then %q for the same params", first, again)
+	}
+}
+
+func TestSceneCodec_noStore(t *testing.T) {
+	c := &SceneCodec{}
+	if _, err := c.Encode(context.Background(), map[string]string{"name": "中文"}); err == nil {
+		t.Error("SceneCodec.Encode returned nil err without store")
+	}
+	if _, err := c.Decode(context.Background(), "$1"); err != ErrSceneNotFound {
+		t.Errorf("SceneCodec.Decode err = %v, want %v", err, ErrSceneNotFound)
+	}
+}
+
+func TestSceneCodec_Decode(t *testing.T) {
+	c := &SceneCodec{Store: NewMemorySceneStore()}
+	got, err := c.Decode(context.Background(), "uid%3D42%26c%3Dspring")
+	if err != nil {
+		t.Fatalf("SceneCodec.Decode returned err: %v", err)
+	}
+	if want := map[string]string{"uid": "42", "c": "spring"}; !reflect.DeepEqual(want, got) {
+		t.Errorf("SceneCodec.Decode got %v, want %v", got, want)
+	}
+
+	for _, scene := range []string{"a b", "uid", "=1", strings.Repeat("a=1", 11)} {
+		if _, err := c.Decode(context.Background(), scene); err == nil {
+			t.Errorf("SceneCodec.Decode(%q) returned nil err", scene)
+		}
+	}
+	if _, err := c.Decode(context.Background(), "$zz"); err != ErrSceneNotFound {
+		t.Errorf("SceneCodec.Decode err = %v, want %v", err, ErrSceneNotFound)
+	}
+}
@@ -0,0 +1,70 @@
+package wechat
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// SearchStatus represents whether the mini program can be found by WeChat
+// search.
+type SearchStatus int
+
+// Search statuses.
+const (
+	SearchStatusVisible SearchStatus = 0 // 可被搜索
+	SearchStatusHidden  SearchStatus = 1 // 不可被搜索
+)
+
+// WXASearchStatus represents get wxa search status response.
+type WXASearchStatus struct {
+	Status SearchStatus `json:"status"`
+}
+
+// GetSearchStatus fetch whether the mini program can be found by WeChat
+// search.
+// Wechat API docs:
+// https://developers.weixin.qq.com/doc/oplatform/Third-party_Platforms/Mini_Programs/Mini_Program_Basic_Info/getwxasearchstatus.html
+func (s *WXAService) GetSearchStatus(ctx context.Context, token string) (*WXASearchStatus, *Response, error) {
+	u := fmt.Sprintf("wxa/getwxasearchstatus?access_token=%v", token)
+	req, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	status := new(WXASearchStatus)
+	resp, err := s.client.Do(ctx, req, status)
+	if err != nil {
+		return nil, resp, err
+	}
+	return status, resp, nil
+}
+
+// ChangeSearchStatus set whether the mini program can be found by WeChat
+// search.
+// Wechat API docs:
+// https://developers.weixin.qq.com/doc/oplatform/Third-party_Platforms/Mini_Programs/Mini_Program_Basic_Info/changewxasearchstatus.html
+func (s *WXAService) ChangeSearchStatus(ctx context.Context, token string, status SearchStatus) (*Response, error) {
+	u := fmt.Sprintf("wxa/changewxasearchstatus?access_token=%v", token)
+	payload := &WXASearchStatus{Status: status}
+	req, err := s.client.NewRequest(http.MethodPost, u, payload)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
+
+// EnsureSearchStatus set the search status to status unless it already is,
+// and reports whether it was changed.
+func (s *WXAService) EnsureSearchStatus(ctx context.Context, token string, status SearchStatus) (bool, error) {
+	current, _, err := s.GetSearchStatus(ctx, token)
+	if err != nil {
+		return false, err
+	}
+	if current.Status == status {
+		return false, nil
+	}
+	if _, err := s.ChangeSearchStatus(ctx, token, status); err != nil {
+		return false, err
+	}
+	return true, nil
+}
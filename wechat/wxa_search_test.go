@@ -0,0 +1,70 @@
+package wechat
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestWXAService_GetSearchStatus(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/wxa/getwxasearchstatus", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"errcode": 0, "errmsg": "ok", "status": 1}`)
+	})
+	got, _, err := client.WXA.GetSearchStatus(context.Background(), "token")
+	if err != nil {
+		t.Errorf("WXA.GetSearchStatus retured err: %v", err)
+	}
+	want := &WXASearchStatus{Status: SearchStatusHidden}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("WXA.GetSearchStatus got %+v, want %+v", got, want)
+	}
+}
+
+func TestWXAService_ChangeSearchStatus(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/wxa/changewxasearchstatus", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"status":1}`+"\n")
+		fmt.Fprint(w, `{"errcode": 0, "errmsg": "ok"}`)
+	})
+	_, err := client.WXA.ChangeSearchStatus(context.Background(), "token", SearchStatusHidden)
+	if err != nil {
+		t.Errorf("WXA.ChangeSearchStatus retured err: %v", err)
+	}
+}
+
+func TestWXAService_EnsureSearchStatus(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	current := SearchStatusVisible
+	changes := 0
+	mux.HandleFunc("/wxa/getwxasearchstatus", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"errcode": 0, "status": %d}`, current)
+	})
+	mux.HandleFunc("/wxa/changewxasearchstatus", func(w http.ResponseWriter, r *http.Request) {
+		changes++
+		current = SearchStatusHidden
+		fmt.Fprint(w, `{"errcode": 0}`)
+	})
+	for i, want := range []bool{true, false} {
+		changed, err := client.WXA.EnsureSearchStatus(context.Background(), "token", SearchStatusHidden)
+		if err != nil {
+			t.Errorf("WXA.EnsureSearchStatus retured err: %v", err)
+		}
+		if changed != want {
+			t.Errorf("WXA.EnsureSearchStatus call %d changed = %v, want %v", i, changed, want)
+		}
+	}
+	if changes != 1 {
+		t.Errorf("WXA.EnsureSearchStatus changed the status %d times, want 1", changes)
+	}
+}
@@ -0,0 +1,105 @@
+package wechat
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// VersionUsage represents the percentage of users on a base library version.
+type VersionUsage struct {
+	Percentage float64 `json:"percentage"`
+	Version    string  `json:"version"`
+}
+
+// UVInfo represents the base library version distribution.
+type UVInfo struct {
+	Items []*VersionUsage `json:"items"`
+}
+
+// WeappSupportVersion represents get weapp support version response.
+type WeappSupportVersion struct {
+	NowVersion string  `json:"now_version"`
+	UVInfo     *UVInfo `json:"uv_info"`
+}
+
+// SuggestVersion returns the highest base library version which still covers
+// at least coverage percent of users, e.g. 95 for 95%. It returns false if the
+// distribution does not reach coverage at all.
+func (v *WeappSupportVersion) SuggestVersion(coverage float64) (string, bool) {
+	if v.UVInfo == nil {
+		return "", false
+	}
+	items := make([]*VersionUsage, len(v.UVInfo.Items))
+	copy(items, v.UVInfo.Items)
+	sort.Slice(items, func(i, j int) bool {
+		return compareVersion(items[i].Version, items[j].Version) > 0
+	})
+	var total float64
+	for _, item := range items {
+		total += item.Percentage
+		if total >= coverage {
+			return item.Version, true
+		}
+	}
+	return "", false
+}
+
+// compareVersion compares dotted versions such as "2.10.1" numerically.
+func compareVersion(a, b string) int {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		var x, y int
+		if i < len(as) {
+			x, _ = strconv.Atoi(as[i])
+		}
+		if i < len(bs) {
+			y, _ = strconv.Atoi(bs[i])
+		}
+		if x != y {
+			if x > y {
+				return 1
+			}
+			return -1
+		}
+	}
+	return 0
+}
+
+// GetWeappSupportVersion fetch the minimum base library version and the
+// version distribution of users.
+//
+// Wechat API docs:
+// https://developers.weixin.qq.com/doc/oplatform/Third-party_Platforms/Mini_Programs/Base_Library_Version_Setting.html
+func (s *WXAService) GetWeappSupportVersion(ctx context.Context, token string) (*WeappSupportVersion, *Response, error) {
+	u := fmt.Sprintf("cgi-bin/wxopen/getweappsupportversion?access_token=%v", token)
+	req, err := s.client.NewRequest(http.MethodPost, u, struct{}{})
+	if err != nil {
+		return nil, nil, err
+	}
+	version := new(WeappSupportVersion)
+	resp, err := s.client.Do(ctx, req, version)
+	if err != nil {
+		return nil, resp, err
+	}
+	return version, resp, nil
+}
+
+// SetWeappSupportVersion set the minimum base library version.
+//
+// Wechat API docs:
+// https://developers.weixin.qq.com/doc/oplatform/Third-party_Platforms/Mini_Programs/Base_Library_Version_Setting.html
+func (s *WXAService) SetWeappSupportVersion(ctx context.Context, token, version string) (*Response, error) {
+	u := fmt.Sprintf("cgi-bin/wxopen/setweappsupportversion?access_token=%v", token)
+	payload := struct {
+		Version string `json:"version"`
+	}{Version: version}
+	req, err := s.client.NewRequest(http.MethodPost, u, payload)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
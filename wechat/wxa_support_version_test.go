@@ -0,0 +1,91 @@
+package wechat
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestWXAService_GetWeappSupportVersion(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/cgi-bin/wxopen/getweappsupportversion", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprint(w, `{
+							  "errcode": 0,
+							  "errmsg": "ok",
+							  "now_version": "2.10.0",
+							  "uv_info": {
+								"items": [
+								  {"percentage": 2, "version": "2.9.0"},
+								  {"percentage": 30, "version": "2.10.0"}
+								]
+							  }
+							}`)
+	})
+	got, _, err := client.WXA.GetWeappSupportVersion(context.Background(), "token")
+	if err != nil {
+		t.Errorf("WXA.GetWeappSupportVersion retured err: %v", err)
+	}
+	want := &WeappSupportVersion{
+		NowVersion: "2.10.0",
+		UVInfo: &UVInfo{Items: []*VersionUsage{
+			{Percentage: 2, Version: "2.9.0"},
+			{Percentage: 30, Version: "2.10.0"},
+		}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("WXA.GetWeappSupportVersion got %+v, want %+v", got, want)
+	}
+}
+
+func TestWXAService_SetWeappSupportVersion(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/cgi-bin/wxopen/setweappsupportversion", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		body, _ := ioutil.ReadAll(r.Body)
+		if got, want := string(body), `{"version":"2.10.0"}`+"\n"; got != want {
+			t.Errorf("Request body = %v, want %v", got, want)
+		}
+		fmt.Fprint(w, `{
+							  "errcode": 0,
+							  "errmsg": "ok"
+							}`)
+	})
+	_, err := client.WXA.SetWeappSupportVersion(context.Background(), "token", "2.10.0")
+	if err != nil {
+		t.Errorf("WXA.SetWeappSupportVersion retured err: %v", err)
+	}
+}
+
+func TestWeappSupportVersion_SuggestVersion(t *testing.T) {
+	v := &WeappSupportVersion{UVInfo: &UVInfo{Items: []*VersionUsage{
+		{Percentage: 5, Version: "2.9.0"},
+		{Percentage: 60, Version: "2.10.1"},
+		{Percentage: 30, Version: "2.10.0"},
+		{Percentage: 4, Version: "1.9.9"},
+	}}}
+	tests := []struct {
+		coverage float64
+		want     string
+		ok       bool
+	}{
+		{50, "2.10.1", true},
+		{90, "2.10.0", true},
+		{95, "2.9.0", true},
+		{99, "1.9.9", true},
+		{100, "", false},
+	}
+	for _, tt := range tests {
+		got, ok := v.SuggestVersion(tt.coverage)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("SuggestVersion(%v) = %q, %v, want %q, %v", tt.coverage, got, ok, tt.want, tt.ok)
+		}
+	}
+}
@@ -0,0 +1,199 @@
+package wechat
+
+import (
+	"context"
+)
+
+// Bind tester errcodes.
+const (
+	errCodeTesterLimit         = 85002 // 小程序绑定的体验者数量达到上限
+	errCodeTesterAlreadyExists = 85004 // 微信号已经绑定
+)
+
+// SyncTestersOptions represents options of SyncTesters.
+type SyncTestersOptions struct {
+	// DryRun reports the changes without applying them.
+	DryRun bool
+	// Known maps WeChat IDs to the userstr of their tester, as returned in
+	// TesterSyncReport.Known by the previous sync. MemberAuth only returns
+	// userstr values, so without it a desired WeChat ID which is already a
+	// tester cannot be matched with its userstr.
+	Known map[string]string
+}
+
+// TesterSyncReport represents the changes made by SyncTesters.
+type TesterSyncReport struct {
+	DryRun bool
+	// Bound maps the bound WeChat IDs to their userstr. In dry-run it
+	// holds the WeChat IDs to bind, with empty userstr.
+	Bound map[string]string
+	// Unbound are the unbound (or to unbind in dry-run) userstr values.
+	Unbound []string
+	// Unchanged are the desired values which are already testers.
+	Unchanged []string
+	// AlreadyBound are the desired WeChat IDs which turned out to be
+	// testers already, with an unknown userstr.
+	AlreadyBound []string
+	// Skipped are the userstr values left bound because they may belong
+	// to one of AlreadyBound, or to a WeChat ID whose bind failed. In
+	// dry-run, they are the unbinds which are skipped if a WeChat ID of
+	// Bound is already a tester or fails to bind.
+	Skipped []string
+	// Uncertain is set in dry-run when the WeChat IDs of Bound may already
+	// be testers, which cannot be told without binding them. The run then
+	// reports them as AlreadyBound instead, and unbinds Skipped only if
+	// none is and all the binds succeed.
+	Uncertain bool
+	// Failed maps the WeChat IDs or userstr values to their error.
+	Failed map[string]error
+	// Known maps WeChat IDs to the userstr of their tester after the sync,
+	// to pass as SyncTestersOptions.Known to the next sync.
+	Known map[string]string
+}
+
+// SyncTesters binds and unbinds testers so that the testers of the app match
+// desired. Each desired value is either the userstr of a current tester, as
+// returned by BindTester or MemberAuth, or a WeChat ID, matched with its
+// userstr through opts.Known. Testers which are not desired are unbound,
+// except those whose owner is unknown when a desired WeChat ID turns out to
+// be already bound, as one of them is its userstr, or fails to bind.
+//
+// Failures of individual binds and unbinds are reported in the
+// TesterSyncReport, the returned error is only set when the sync cannot run.
+func (s *WXAService) SyncTesters(ctx context.Context, token string, desired []string, opts *SyncTestersOptions) (*TesterSyncReport, error) {
+	if opts == nil {
+		opts = &SyncTestersOptions{}
+	}
+	testers, _, err := s.MemberAuth(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	current := make(map[string]bool, len(testers.Members))
+	for _, m := range testers.Members {
+		current[m.UserString] = true
+	}
+
+	report := &TesterSyncReport{
+		DryRun: opts.DryRun,
+		Bound:  make(map[string]string),
+		Failed: make(map[string]error),
+		Known:  make(map[string]string),
+	}
+	// owned are the current userstr values whose WeChat ID is known.
+	owned := make(map[string]bool)
+	for id, userStr := range opts.Known {
+		if current[userStr] {
+			owned[userStr] = true
+			report.Known[id] = userStr
+		}
+	}
+	seen := make(map[string]bool)
+	matched := make(map[string]bool)
+	var toBind []string
+	for _, d := range desired {
+		if d == "" || seen[d] {
+			continue
+		}
+		seen[d] = true
+		userStr := d
+		if !current[d] {
+			userStr = report.Known[d]
+		}
+		if userStr != "" {
+			matched[userStr] = true
+			report.Unchanged = append(report.Unchanged, d)
+			continue
+		}
+		toBind = append(toBind, d)
+	}
+	// Unbinds of testers whose owner is unknown are ambiguous: they may
+	// belong to a WeChat ID to bind.
+	var toUnbind, ambiguous []string
+	for _, m := range testers.Members {
+		switch {
+		case matched[m.UserString]:
+		case owned[m.UserString] || len(toBind) == 0:
+			toUnbind = append(toUnbind, m.UserString)
+		default:
+			ambiguous = append(ambiguous, m.UserString)
+		}
+	}
+
+	if opts.DryRun {
+		for _, id := range toBind {
+			report.Bound[id] = ""
+		}
+		report.Unbound = toUnbind
+		report.Skipped = ambiguous
+		report.Uncertain = len(ambiguous) > 0
+		return report, nil
+	}
+
+	// Binds failing because the tester limit is reached are retried once
+	// the undesired testers are unbound. Other failures tell nothing about
+	// whether the WeChat ID is already a tester.
+	var retry []string
+	bindFailed := false
+	for _, id := range toBind {
+		if err := s.syncBindTester(ctx, token, id, report); err != nil {
+			if errorCode(err) == errCodeTesterLimit {
+				retry = append(retry, id)
+				continue
+			}
+			bindFailed = true
+			if ctx.Err() != nil {
+				return report, ctx.Err()
+			}
+		}
+	}
+
+	switch {
+	case len(report.AlreadyBound) == 0 && !bindFailed:
+		toUnbind = append(toUnbind, ambiguous...)
+	case len(report.AlreadyBound) == 1 && len(ambiguous) == 1:
+		// The only tester with an unknown owner is the one already bound.
+		report.Known[report.AlreadyBound[0]] = ambiguous[0]
+	default:
+		report.Skipped = ambiguous
+	}
+	for _, userStr := range toUnbind {
+		if _, err := s.UnBindTester(ctx, token, &UnBindTesterRequest{UserString: userStr}); err != nil {
+			report.Failed[userStr] = err
+			if ctx.Err() != nil {
+				return report, ctx.Err()
+			}
+			continue
+		}
+		report.Unbound = append(report.Unbound, userStr)
+		for id, u := range report.Known {
+			if u == userStr {
+				delete(report.Known, id)
+			}
+		}
+	}
+
+	for _, id := range retry {
+		if err := s.syncBindTester(ctx, token, id, report); err != nil && ctx.Err() != nil {
+			return report, ctx.Err()
+		}
+	}
+	return report, nil
+}
+
+// syncBindTester binds a tester and records the result in report.
+func (s *WXAService) syncBindTester(ctx context.Context, token, wechatID string, report *TesterSyncReport) error {
+	tester, _, err := s.BindTester(ctx, token, &BindTesterRequest{WechatID: wechatID})
+	switch {
+	case err == nil:
+		delete(report.Failed, wechatID)
+		report.Bound[wechatID] = tester.UserString
+		report.Known[wechatID] = tester.UserString
+	case errorCode(err) == errCodeTesterAlreadyExists:
+		delete(report.Failed, wechatID)
+		report.AlreadyBound = append(report.AlreadyBound, wechatID)
+		return nil
+	default:
+		report.Failed[wechatID] = err
+	}
+	return err
+}
@@ -0,0 +1,277 @@
+package wechat
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// testersServer serves the tester endpoints from an in-memory tester list,
+// limited to limit testers.
+type testersServer struct {
+	members map[string]string // userstr to wechat id
+	limit   int
+	busy    map[string]bool // wechat ids whose bind fails with a system error
+	binds   []string
+	unbinds []string
+}
+
+func (s *testersServer) register(mux *http.ServeMux) {
+	mux.HandleFunc("/wxa/memberauth", func(w http.ResponseWriter, r *http.Request) {
+		testers := new(Testers)
+		for userStr := range s.members {
+			testers.Members = append(testers.Members, &Tester{UserString: userStr})
+		}
+		json.NewEncoder(w).Encode(testers)
+	})
+	mux.HandleFunc("/wxa/bind_tester", func(w http.ResponseWriter, r *http.Request) {
+		req := new(BindTesterRequest)
+		json.NewDecoder(r.Body).Decode(req)
+		s.binds = append(s.binds, req.WechatID)
+		if s.busy[req.WechatID] {
+			fmt.Fprint(w, `{"errcode": -1, "errmsg": "system busy"}`)
+			return
+		}
+		for _, id := range s.members {
+			if id == req.WechatID {
+				fmt.Fprint(w, `{"errcode": 85004, "errmsg": "already bound"}`)
+				return
+			}
+		}
+		if len(s.members) >= s.limit {
+			fmt.Fprint(w, `{"errcode": 85002, "errmsg": "limit"}`)
+			return
+		}
+		userStr := "u-" + req.WechatID
+		s.members[userStr] = req.WechatID
+		fmt.Fprintf(w, `{"errcode": 0, "errmsg": "ok", "userstr": %q}`, userStr)
+	})
+	mux.HandleFunc("/wxa/unbind_tester", func(w http.ResponseWriter, r *http.Request) {
+		req := new(UnBindTesterRequest)
+		json.NewDecoder(r.Body).Decode(req)
+		s.unbinds = append(s.unbinds, req.UserString)
+		delete(s.members, req.UserString)
+		fmt.Fprint(w, `{"errcode": 0, "errmsg": "ok"}`)
+	})
+}
+
+func TestWXAService_SyncTesters(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	s := &testersServer{
+		members: map[string]string{"u-alice": "alice", "u-bob": "bob"},
+		limit:   2,
+	}
+	s.register(mux)
+
+	got, err := client.WXA.SyncTesters(context.Background(), "token", []string{"u-alice", "carol"}, nil)
+	if err != nil {
+		t.Fatalf("WXA.SyncTesters returned err: %v", err)
+	}
+	want := &TesterSyncReport{
+		Bound:     map[string]string{"carol": "u-carol"},
+		Unbound:   []string{"u-bob"},
+		Unchanged: []string{"u-alice"},
+		Failed:    map[string]error{},
+		Known:     map[string]string{"carol": "u-carol"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("WXA.SyncTesters got %+v, want %+v", got, want)
+	}
+	// carol is retried after bob is unbound, as the limit was reached.
+	if want := []string{"carol", "carol"}; !reflect.DeepEqual(s.binds, want) {
+		t.Errorf("WXA.SyncTesters binds = %v, want %v", s.binds, want)
+	}
+}
+
+func TestWXAService_SyncTesters_alreadyBound(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	s := &testersServer{
+		members: map[string]string{"u-alice": "alice", "u-bob": "bob"},
+		limit:   10,
+	}
+	s.register(mux)
+
+	got, err := client.WXA.SyncTesters(context.Background(), "token", []string{"alice"}, nil)
+	if err != nil {
+		t.Fatalf("WXA.SyncTesters returned err: %v", err)
+	}
+	if want := []string{"alice"}; !reflect.DeepEqual(got.AlreadyBound, want) {
+		t.Errorf("WXA.SyncTesters AlreadyBound = %v, want %v", got.AlreadyBound, want)
+	}
+	if len(got.Skipped) != 2 || len(s.unbinds) != 0 {
+		t.Errorf("WXA.SyncTesters skipped %v and unbound %v, want all skipped", got.Skipped, s.unbinds)
+	}
+}
+
+func TestWXAService_SyncTesters_known(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	s := &testersServer{
+		members: map[string]string{"u-alice": "alice", "u-bob": "bob"},
+		limit:   10,
+	}
+	s.register(mux)
+
+	got, err := client.WXA.SyncTesters(context.Background(), "token", []string{"alice", "carol"}, &SyncTestersOptions{
+		Known: map[string]string{"alice": "u-alice", "bob": "u-bob", "dave": "u-dave"},
+	})
+	if err != nil {
+		t.Fatalf("WXA.SyncTesters returned err: %v", err)
+	}
+	want := &TesterSyncReport{
+		Bound:     map[string]string{"carol": "u-carol"},
+		Unbound:   []string{"u-bob"},
+		Unchanged: []string{"alice"},
+		Failed:    map[string]error{},
+		Known:     map[string]string{"alice": "u-alice", "carol": "u-carol"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("WXA.SyncTesters got %+v, want %+v", got, want)
+	}
+	if want := []string{"carol"}; !reflect.DeepEqual(s.binds, want) {
+		t.Errorf("WXA.SyncTesters binds = %v, want %v", s.binds, want)
+	}
+
+	// Fed back, the next sync has nothing to do.
+	got, err = client.WXA.SyncTesters(context.Background(), "token", []string{"alice", "carol"}, &SyncTestersOptions{Known: got.Known})
+	if err != nil {
+		t.Fatalf("WXA.SyncTesters returned err: %v", err)
+	}
+	if len(got.Bound) != 0 || len(got.Unbound) != 0 || len(s.binds) != 1 {
+		t.Errorf("WXA.SyncTesters second run bound %v and unbound %v", got.Bound, got.Unbound)
+	}
+}
+
+func TestWXAService_SyncTesters_onlyAmbiguousSkipped(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	s := &testersServer{
+		members: map[string]string{"u-alice": "alice", "u-bob": "bob", "u-dave": "dave"},
+		limit:   10,
+	}
+	s.register(mux)
+
+	got, err := client.WXA.SyncTesters(context.Background(), "token", []string{"alice"}, &SyncTestersOptions{
+		Known: map[string]string{"bob": "u-bob"},
+	})
+	if err != nil {
+		t.Fatalf("WXA.SyncTesters returned err: %v", err)
+	}
+	sort.Strings(got.Skipped)
+	if want := []string{"u-alice", "u-dave"}; !reflect.DeepEqual(got.Skipped, want) {
+		t.Errorf("WXA.SyncTesters Skipped = %v, want %v", got.Skipped, want)
+	}
+	if want := []string{"u-bob"}; !reflect.DeepEqual(s.unbinds, want) {
+		t.Errorf("WXA.SyncTesters unbinds = %v, want %v", s.unbinds, want)
+	}
+}
+
+func TestWXAService_SyncTesters_resolvesAlreadyBound(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	s := &testersServer{
+		members: map[string]string{"u-alice": "alice", "u-bob": "bob"},
+		limit:   10,
+	}
+	s.register(mux)
+
+	got, err := client.WXA.SyncTesters(context.Background(), "token", []string{"alice"}, &SyncTestersOptions{
+		Known: map[string]string{"bob": "u-bob"},
+	})
+	if err != nil {
+		t.Fatalf("WXA.SyncTesters returned err: %v", err)
+	}
+	if len(got.Skipped) != 0 || got.Known["alice"] != "u-alice" {
+		t.Errorf("WXA.SyncTesters Skipped = %v, Known = %v, want alice resolved to u-alice", got.Skipped, got.Known)
+	}
+	if want := []string{"u-bob"}; !reflect.DeepEqual(s.unbinds, want) {
+		t.Errorf("WXA.SyncTesters unbinds = %v, want %v", s.unbinds, want)
+	}
+}
+
+func TestWXAService_SyncTesters_bindFailed(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	s := &testersServer{
+		members: map[string]string{"u-carol": "carol"},
+		limit:   10,
+		busy:    map[string]bool{"carol": true},
+	}
+	s.register(mux)
+
+	got, err := client.WXA.SyncTesters(context.Background(), "token", []string{"carol"}, nil)
+	if err != nil {
+		t.Fatalf("WXA.SyncTesters returned err: %v", err)
+	}
+	if want := []string{"u-carol"}; !reflect.DeepEqual(got.Skipped, want) {
+		t.Errorf("WXA.SyncTesters Skipped = %v, want %v", got.Skipped, want)
+	}
+	if got.Failed["carol"] == nil {
+		t.Error("WXA.SyncTesters did not report the failed bind")
+	}
+	if len(s.unbinds) != 0 {
+		t.Errorf("WXA.SyncTesters unbinds = %v, want none", s.unbinds)
+	}
+}
+
+func TestWXAService_SyncTesters_dryRun(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	s := &testersServer{
+		members: map[string]string{"u-alice": "alice", "u-bob": "bob"},
+		limit:   10,
+	}
+	s.register(mux)
+
+	// Without a mapping, bob may be the tester of alice.
+	got, err := client.WXA.SyncTesters(context.Background(), "token", []string{"alice"}, &SyncTestersOptions{DryRun: true})
+	if err != nil {
+		t.Fatalf("WXA.SyncTesters returned err: %v", err)
+	}
+	sort.Strings(got.Skipped)
+	want := &TesterSyncReport{
+		DryRun:    true,
+		Bound:     map[string]string{"alice": ""},
+		Skipped:   []string{"u-alice", "u-bob"},
+		Uncertain: true,
+		Failed:    map[string]error{},
+		Known:     map[string]string{},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("WXA.SyncTesters got %+v, want %+v", got, want)
+	}
+
+	// With a mapping, the dry-run predicts the run.
+	opts := &SyncTestersOptions{DryRun: true, Known: map[string]string{"alice": "u-alice", "bob": "u-bob"}}
+	dry, err := client.WXA.SyncTesters(context.Background(), "token", []string{"alice", "carol"}, opts)
+	if err != nil {
+		t.Fatalf("WXA.SyncTesters returned err: %v", err)
+	}
+	if len(s.binds) != 0 || len(s.unbinds) != 0 {
+		t.Errorf("WXA.SyncTesters dry-run bound %v and unbound %v", s.binds, s.unbinds)
+	}
+	opts.DryRun = false
+	run, err := client.WXA.SyncTesters(context.Background(), "token", []string{"alice", "carol"}, opts)
+	if err != nil {
+		t.Fatalf("WXA.SyncTesters returned err: %v", err)
+	}
+	if dry.Uncertain || len(dry.Skipped) != 0 {
+		t.Errorf("WXA.SyncTesters dry-run is uncertain, skipped %v", dry.Skipped)
+	}
+	if !reflect.DeepEqual(dry.Unbound, run.Unbound) || !reflect.DeepEqual(dry.Unchanged, run.Unchanged) || len(dry.Bound) != len(run.Bound) {
+		t.Errorf("WXA.SyncTesters dry-run %+v does not predict run %+v", dry, run)
+	}
+}
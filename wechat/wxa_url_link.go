@@ -0,0 +1,211 @@
+package wechat
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// EnvVersion represents the version of a mini program to open.
+type EnvVersion string
+
+// Env versions.
+const (
+	EnvVersionRelease EnvVersion = "release" // 正式版
+	EnvVersionTrial   EnvVersion = "trial"   // 体验版
+	EnvVersionDevelop EnvVersion = "develop" // 开发版
+)
+
+// ExpireType represents how the expiry of a link is given.
+type ExpireType int
+
+// Expire types.
+const (
+	ExpireTypeTime     ExpireType = 0 // 到期失效, uses ExpireTime
+	ExpireTypeInterval ExpireType = 1 // 间隔天数失效, uses ExpireInterval
+)
+
+// LinkExpiry represents the expiry of a URL scheme or URL link. The zero
+// value means the link does not expire.
+type LinkExpiry struct {
+	IsExpire       bool       `json:"is_expire,omitempty"`
+	ExpireType     ExpireType `json:"expire_type,omitempty"`
+	ExpireTime     int64      `json:"expire_time,omitempty"`
+	ExpireInterval int        `json:"expire_interval,omitempty"`
+}
+
+// ExpireAt returns a LinkExpiry expiring at t.
+func ExpireAt(t time.Time) LinkExpiry {
+	return LinkExpiry{IsExpire: true, ExpireType: ExpireTypeTime, ExpireTime: t.Unix()}
+}
+
+// ExpireAfter returns a LinkExpiry expiring days days after the link is
+// generated.
+func ExpireAfter(days int) LinkExpiry {
+	return LinkExpiry{IsExpire: true, ExpireType: ExpireTypeInterval, ExpireInterval: days}
+}
+
+// JumpWXA represents the page a URL scheme opens.
+type JumpWXA struct {
+	Path       string     `json:"path,omitempty"`
+	Query      string     `json:"query,omitempty"`
+	EnvVersion EnvVersion `json:"env_version,omitempty"`
+}
+
+// GenerateSchemeRequest represents request of generate scheme.
+type GenerateSchemeRequest struct {
+	JumpWXA *JumpWXA `json:"jump_wxa,omitempty"`
+	LinkExpiry
+}
+
+// Scheme represents generate scheme response.
+type Scheme struct {
+	OpenLink string `json:"openlink"`
+}
+
+// GenerateScheme generate a URL scheme opening the mini program.
+// Wechat API docs:
+// https://developers.weixin.qq.com/miniprogram/dev/api-backend/open-api/url-scheme/urlscheme.generate.html
+func (s *WXAService) GenerateScheme(ctx context.Context, token string, r *GenerateSchemeRequest) (*Scheme, *Response, error) {
+	u := fmt.Sprintf("wxa/generatescheme?access_token=%v", token)
+	req, err := s.client.NewRequest(http.MethodPost, u, r)
+	if err != nil {
+		return nil, nil, err
+	}
+	scheme := new(Scheme)
+	resp, err := s.client.Do(ctx, req, scheme)
+	if err != nil {
+		return nil, resp, err
+	}
+	return scheme, resp, nil
+}
+
+// LinkQuota represents the quota of long-lived links.
+type LinkQuota struct {
+	LongTimeUsed  int `json:"long_time_used"`
+	LongTimeLimit int `json:"long_time_limit"`
+}
+
+// LinkInfo represents the page a URL scheme or URL link opens.
+type LinkInfo struct {
+	AppID      string     `json:"appid"`
+	Path       string     `json:"path"`
+	Query      string     `json:"query"`
+	CreateTime int64      `json:"create_time"`
+	ExpireTime int64      `json:"expire_time"`
+	EnvVersion EnvVersion `json:"env_version"`
+}
+
+// SchemeInfo represents query scheme response.
+type SchemeInfo struct {
+	SchemeInfo  *LinkInfo  `json:"scheme_info"`
+	SchemeQuota *LinkQuota `json:"scheme_quota,omitempty"`
+	VisitOpenID string     `json:"visit_openid,omitempty"`
+}
+
+// QueryScheme fetch the page, the visitor and the quota of a URL scheme.
+// Wechat API docs:
+// https://developers.weixin.qq.com/miniprogram/dev/api-backend/open-api/url-scheme/urlscheme.query.html
+func (s *WXAService) QueryScheme(ctx context.Context, token, scheme string) (*SchemeInfo, *Response, error) {
+	u := fmt.Sprintf("wxa/queryscheme?access_token=%v", token)
+	payload := struct {
+		Scheme string `json:"scheme"`
+	}{Scheme: scheme}
+	req, err := s.client.NewRequest(http.MethodPost, u, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+	info := new(SchemeInfo)
+	resp, err := s.client.Do(ctx, req, info)
+	if err != nil {
+		return nil, resp, err
+	}
+	return info, resp, nil
+}
+
+// GenerateURLLinkRequest represents request of generate url link.
+type GenerateURLLinkRequest struct {
+	Path       string     `json:"path,omitempty"`
+	Query      string     `json:"query,omitempty"`
+	EnvVersion EnvVersion `json:"env_version,omitempty"`
+	LinkExpiry
+}
+
+// URLLink represents generate url link response.
+type URLLink struct {
+	URLLink string `json:"url_link"`
+}
+
+// GenerateURLLink generate a URL link opening the mini program.
+// Wechat API docs:
+// https://developers.weixin.qq.com/miniprogram/dev/api-backend/open-api/url-link/urllink.generate.html
+func (s *WXAService) GenerateURLLink(ctx context.Context, token string, r *GenerateURLLinkRequest) (*URLLink, *Response, error) {
+	u := fmt.Sprintf("wxa/generate_urllink?access_token=%v", token)
+	req, err := s.client.NewRequest(http.MethodPost, u, r)
+	if err != nil {
+		return nil, nil, err
+	}
+	link := new(URLLink)
+	resp, err := s.client.Do(ctx, req, link)
+	if err != nil {
+		return nil, resp, err
+	}
+	return link, resp, nil
+}
+
+// URLLinkInfo represents query url link response.
+type URLLinkInfo struct {
+	URLLinkInfo  *LinkInfo  `json:"url_link_info"`
+	URLLinkQuota *LinkQuota `json:"url_link_quota,omitempty"`
+	VisitOpenID  string     `json:"visit_openid,omitempty"`
+}
+
+// QueryURLLink fetch the page, the visitor and the quota of a URL link.
+// Wechat API docs:
+// https://developers.weixin.qq.com/miniprogram/dev/api-backend/open-api/url-link/urllink.query.html
+func (s *WXAService) QueryURLLink(ctx context.Context, token, urlLink string) (*URLLinkInfo, *Response, error) {
+	u := fmt.Sprintf("wxa/query_urllink?access_token=%v", token)
+	payload := struct {
+		URLLink string `json:"url_link"`
+	}{URLLink: urlLink}
+	req, err := s.client.NewRequest(http.MethodPost, u, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+	info := new(URLLinkInfo)
+	resp, err := s.client.Do(ctx, req, info)
+	if err != nil {
+		return nil, resp, err
+	}
+	return info, resp, nil
+}
+
+// GenerateShortLinkRequest represents request of generate short link.
+type GenerateShortLinkRequest struct {
+	PageURL     string `json:"page_url"`
+	PageTitle   string `json:"page_title,omitempty"`
+	IsPermanent bool   `json:"is_permanent,omitempty"`
+}
+
+// ShortLink represents generate short link response.
+type ShortLink struct {
+	Link string `json:"link"`
+}
+
+// GenerateShortLink generate a short link opening the mini program.
+// Wechat API docs:
+// https://developers.weixin.qq.com/miniprogram/dev/api-backend/open-api/short-link/shortlink.generate.html
+func (s *WXAService) GenerateShortLink(ctx context.Context, token string, r *GenerateShortLinkRequest) (*ShortLink, *Response, error) {
+	u := fmt.Sprintf("wxa/genwxashortlink?access_token=%v", token)
+	req, err := s.client.NewRequest(http.MethodPost, u, r)
+	if err != nil {
+		return nil, nil, err
+	}
+	link := new(ShortLink)
+	resp, err := s.client.Do(ctx, req, link)
+	if err != nil {
+		return nil, resp, err
+	}
+	return link, resp, nil
+}
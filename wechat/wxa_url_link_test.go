@@ -0,0 +1,162 @@
+package wechat
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestWXAService_GenerateScheme(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/wxa/generatescheme", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"jump_wxa":{"path":"pages/index/index","query":"a=1","env_version":"trial"},"is_expire":true,"expire_time":1606737600}`+"\n")
+		fmt.Fprint(w, `{"errcode": 0, "errmsg": "ok", "openlink": "weixin://dl/business/?t=XTSkBZlzqmn"}`)
+	})
+	got, _, err := client.WXA.GenerateScheme(context.Background(), "token", &GenerateSchemeRequest{
+		JumpWXA:    &JumpWXA{Path: "pages/index/index", Query: "a=1", EnvVersion: EnvVersionTrial},
+		LinkExpiry: ExpireAt(time.Unix(1606737600, 0)),
+	})
+	if err != nil {
+		t.Errorf("WXA.GenerateScheme retured err: %v", err)
+	}
+	want := &Scheme{OpenLink: "weixin://dl/business/?t=XTSkBZlzqmn"}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("WXA.GenerateScheme got %+v, want %+v", got, want)
+	}
+}
+
+func TestWXAService_QueryScheme(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/wxa/queryscheme", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"scheme":"weixin://dl/business/?t=XTSkBZlzqmn"}`+"\n")
+		fmt.Fprint(w, `{
+							  "errcode": 0,
+							  "errmsg": "ok",
+							  "scheme_info": {
+								"appid": "appid",
+								"path": "pages/index/index",
+								"query": "a=1",
+								"create_time": 611877546,
+								"expire_time": 1606737600,
+								"env_version": "release"
+							  },
+							  "scheme_quota": {"long_time_used": 100, "long_time_limit": 100000},
+							  "visit_openid": "openid"
+							}`)
+	})
+	got, _, err := client.WXA.QueryScheme(context.Background(), "token", "weixin://dl/business/?t=XTSkBZlzqmn")
+	if err != nil {
+		t.Errorf("WXA.QueryScheme retured err: %v", err)
+	}
+	want := &SchemeInfo{
+		SchemeInfo: &LinkInfo{
+			AppID:      "appid",
+			Path:       "pages/index/index",
+			Query:      "a=1",
+			CreateTime: 611877546,
+			ExpireTime: 1606737600,
+			EnvVersion: EnvVersionRelease,
+		},
+		SchemeQuota: &LinkQuota{LongTimeUsed: 100, LongTimeLimit: 100000},
+		VisitOpenID: "openid",
+	}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("WXA.QueryScheme got %+v, want %+v", got, want)
+	}
+}
+
+func TestWXAService_GenerateURLLink(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/wxa/generate_urllink", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"path":"pages/index/index","env_version":"develop","is_expire":true,"expire_type":1,"expire_interval":30}`+"\n")
+		fmt.Fprint(w, `{"errcode": 0, "errmsg": "ok", "url_link": "https://wxaurl.cn/BQZRrcFCPvg"}`)
+	})
+	got, _, err := client.WXA.GenerateURLLink(context.Background(), "token", &GenerateURLLinkRequest{
+		Path:       "pages/index/index",
+		EnvVersion: EnvVersionDevelop,
+		LinkExpiry: ExpireAfter(30),
+	})
+	if err != nil {
+		t.Errorf("WXA.GenerateURLLink retured err: %v", err)
+	}
+	want := &URLLink{URLLink: "https://wxaurl.cn/BQZRrcFCPvg"}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("WXA.GenerateURLLink got %+v, want %+v", got, want)
+	}
+}
+
+func TestWXAService_QueryURLLink(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/wxa/query_urllink", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"url_link":"https://wxaurl.cn/BQZRrcFCPvg"}`+"\n")
+		fmt.Fprint(w, `{
+							  "errcode": 0,
+							  "errmsg": "ok",
+							  "url_link_info": {
+								"appid": "appid",
+								"path": "pages/index/index",
+								"create_time": 611877546,
+								"expire_time": 1609459200,
+								"env_version": "release"
+							  },
+							  "url_link_quota": {"long_time_used": 100, "long_time_limit": 100000},
+							  "visit_openid": "openid"
+							}`)
+	})
+	got, _, err := client.WXA.QueryURLLink(context.Background(), "token", "https://wxaurl.cn/BQZRrcFCPvg")
+	if err != nil {
+		t.Errorf("WXA.QueryURLLink retured err: %v", err)
+	}
+	want := &URLLinkInfo{
+		URLLinkInfo: &LinkInfo{
+			AppID:      "appid",
+			Path:       "pages/index/index",
+			CreateTime: 611877546,
+			ExpireTime: 1609459200,
+			EnvVersion: EnvVersionRelease,
+		},
+		URLLinkQuota: &LinkQuota{LongTimeUsed: 100, LongTimeLimit: 100000},
+		VisitOpenID:  "openid",
+	}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("WXA.QueryURLLink got %+v, want %+v", got, want)
+	}
+}
+
+func TestWXAService_GenerateShortLink(t *testing.T) {
+	client, mux, _, tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/wxa/genwxashortlink", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"page_url":"pages/index/index?a=1","page_title":"首页","is_permanent":true}`+"\n")
+		fmt.Fprint(w, `{"errcode": 0, "errmsg": "ok", "link": "#小程序://小程序示例/首页/ABCD"}`)
+	})
+	got, _, err := client.WXA.GenerateShortLink(context.Background(), "token", &GenerateShortLinkRequest{
+		PageURL:     "pages/index/index?a=1",
+		PageTitle:   "首页",
+		IsPermanent: true,
+	})
+	if err != nil {
+		t.Errorf("WXA.GenerateShortLink retured err: %v", err)
+	}
+	want := &ShortLink{Link: "#小程序://小程序示例/首页/ABCD"}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("WXA.GenerateShortLink got %+v, want %+v", got, want)
+	}
+}